@@ -0,0 +1,69 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+
+	"github.com/sorrawichYooboon/go-protocol-api-style/config"
+	"github.com/sorrawichYooboon/go-protocol-api-style/internal/infrastructure/database"
+	"github.com/sorrawichYooboon/go-protocol-api-style/internal/usecase"
+)
+
+func main() {
+	if err := run(os.Args[1:]); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// run returns errors instead of exiting so the database is always closed.
+func run(args []string) (err error) {
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+
+	if len(args) < 2 {
+		return errors.New("usage: go run cmd/apikey/main.go [issue <name> [scope...]|revoke <id>]")
+	}
+
+	db := database.Connect(cfg)
+	defer func() {
+		if closeErr := database.Close(db); closeErr != nil && err == nil {
+			err = fmt.Errorf("failed to close database: %w", closeErr)
+		}
+	}()
+
+	authUsecase := usecase.NewAuthUsecase(database.NewAPIKeyRepository(db))
+	ctx := context.Background()
+
+	cmd := args[0]
+
+	switch cmd {
+	case "issue":
+		key, apiKey, err := authUsecase.IssueAPIKey(ctx, args[1], args[2:])
+		if err != nil {
+			return fmt.Errorf("issuing API key failed: %w", err)
+		}
+		log.Printf("Issued API key %d (%s) for %q with scopes %q. It is shown only once:", apiKey.ID, apiKey.Prefix, apiKey.Name, apiKey.Scopes)
+		fmt.Println(key)
+
+	case "revoke":
+		id, err := strconv.ParseInt(args[1], 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid API key id: %w", err)
+		}
+		apiKey, err := authUsecase.RevokeAPIKey(ctx, id)
+		if err != nil {
+			return fmt.Errorf("revoking API key failed: %w", err)
+		}
+		log.Printf("Revoked API key %d (%s) for %q.", apiKey.ID, apiKey.Prefix, apiKey.Name)
+
+	default:
+		return fmt.Errorf("unknown command: %s", cmd)
+	}
+	return nil
+}
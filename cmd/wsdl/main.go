@@ -0,0 +1,19 @@
+package main
+
+import (
+	"flag"
+	"log"
+	"os"
+
+	"github.com/sorrawichYooboon/go-protocol-api-style/internal/infrastructure/soap/wsdl"
+)
+
+// Writes the movie service WSDL to stdout, e.g. as input for wsdl2go.
+func main() {
+	address := flag.String("address", "http://localhost:8081/soap/movie", "soap:address location")
+	flag.Parse()
+
+	if err := wsdl.MovieService.Render(os.Stdout, *address); err != nil {
+		log.Fatalf("render wsdl: %v", err)
+	}
+}
@@ -4,18 +4,47 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/joho/godotenv"
 )
 
+// APP_ENV values. Production is the default; development turns on GraphQL
+// introspection and the playground.
+const (
+	AppEnvProduction  = "production"
+	AppEnvDevelopment = "development"
+)
+
 type Config struct {
-	AppEnv           string `yaml:"app_env"`
-	DatabaseHost     string `yaml:"database_host"`
-	DatabasePort     int    `yaml:"database_port"`
-	DatabaseUser     string `yaml:"database_user"`
-	DatabasePassword string `yaml:"database_password"`
-	DatabaseDBName   string `yaml:"database_dbname"`
-	DatabaseSSLMode  string `yaml:"database_sslmode"`
+	AppEnv           string            `yaml:"app_env"`
+	DatabaseHost     string            `yaml:"database_host"`
+	DatabasePort     int               `yaml:"database_port"`
+	DatabaseUser     string            `yaml:"database_user"`
+	DatabasePassword string            `yaml:"database_password"`
+	DatabaseDBName   string            `yaml:"database_dbname"`
+	DatabaseSSLMode  string            `yaml:"database_sslmode"`
+	HTTPPort         string            `yaml:"http_port"`
+	GRPCPort         string            `yaml:"grpc_port"`
+	ShutdownTimeout  time.Duration     `yaml:"shutdown_timeout"`
+	RateLimitRPS     float64           `yaml:"rate_limit_rps"`
+	RateLimitBurst   int               `yaml:"rate_limit_burst"`
+	TrustedProxies   []string          `yaml:"trusted_proxies"`
+	JWTSecret        string            `yaml:"jwt_secret"`
+	JWTJWKSFile      string            `yaml:"jwt_jwks_file"`
+	JWTIssuer        string            `yaml:"jwt_issuer"`
+	JWTAudience      string            `yaml:"jwt_audience"`
+	WSSEUsers        map[string]string `yaml:"wsse_users"`
+	WSSEScopes       []string          `yaml:"wsse_scopes"`
+	SOAPPublicURL    string            `yaml:"soap_public_url"`
+	PosterDir        string            `yaml:"poster_dir"`
+	// GraphQLIntrospection also enables the /playground route.
+	GraphQLIntrospection        bool `yaml:"graphql_introspection"`
+	GraphQLComplexityLimit      int  `yaml:"graphql_complexity_limit"`
+	GraphQLDepthLimit           int  `yaml:"graphql_depth_limit"`
+	GraphQLAPQCacheSize         int  `yaml:"graphql_apq_cache_size"`
+	GraphQLPersistedQueriesOnly bool `yaml:"graphql_persisted_queries_only"`
 }
 
 func LoadConfig() (*Config, error) {
@@ -23,14 +52,85 @@ func LoadConfig() (*Config, error) {
 
 	dbPort, _ := strconv.Atoi(os.Getenv("DATABASE_PORT"))
 
+	shutdownTimeout := 15 * time.Second
+	if v := os.Getenv("SHUTDOWN_TIMEOUT"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil || d <= 0 {
+			return nil, fmt.Errorf("invalid SHUTDOWN_TIMEOUT %q", v)
+		}
+		shutdownTimeout = d
+	}
+
+	rateLimitRPS, err := strconv.ParseFloat(getEnv("RATE_LIMIT_RPS", "10"), 64)
+	if err != nil || rateLimitRPS < 0 {
+		return nil, fmt.Errorf("invalid RATE_LIMIT_RPS %q", os.Getenv("RATE_LIMIT_RPS"))
+	}
+	rateLimitBurst, err := strconv.Atoi(getEnv("RATE_LIMIT_BURST", "100"))
+	if err != nil || rateLimitBurst < 1 {
+		return nil, fmt.Errorf("invalid RATE_LIMIT_BURST %q", os.Getenv("RATE_LIMIT_BURST"))
+	}
+
+	wsseUsers, err := parseWSSEUsers(os.Getenv("WSSE_USERS"))
+	if err != nil {
+		return nil, err
+	}
+
+	// Unknown values are rejected rather than treated as production, so a
+	// typo such as "dev" is noticed instead of silently disabling tooling.
+	appEnv := getEnv("APP_ENV", AppEnvProduction)
+	if appEnv != AppEnvProduction && appEnv != AppEnvDevelopment {
+		return nil, fmt.Errorf("invalid APP_ENV %q, must be %q or %q", appEnv, AppEnvProduction, AppEnvDevelopment)
+	}
+	graphQLIntrospection, err := strconv.ParseBool(getEnv("GRAPHQL_INTROSPECTION", strconv.FormatBool(appEnv == AppEnvDevelopment)))
+	if err != nil {
+		return nil, fmt.Errorf("invalid GRAPHQL_INTROSPECTION %q", os.Getenv("GRAPHQL_INTROSPECTION"))
+	}
+	graphQLComplexityLimit, err := strconv.Atoi(getEnv("GRAPHQL_COMPLEXITY_LIMIT", "2000"))
+	if err != nil || graphQLComplexityLimit < 0 {
+		return nil, fmt.Errorf("invalid GRAPHQL_COMPLEXITY_LIMIT %q", os.Getenv("GRAPHQL_COMPLEXITY_LIMIT"))
+	}
+	graphQLDepthLimit, err := strconv.Atoi(getEnv("GRAPHQL_DEPTH_LIMIT", "10"))
+	if err != nil || graphQLDepthLimit < 0 {
+		return nil, fmt.Errorf("invalid GRAPHQL_DEPTH_LIMIT %q", os.Getenv("GRAPHQL_DEPTH_LIMIT"))
+	}
+	graphQLAPQCacheSize, err := strconv.Atoi(getEnv("GRAPHQL_APQ_CACHE_SIZE", "1000"))
+	if err != nil || graphQLAPQCacheSize < 0 {
+		return nil, fmt.Errorf("invalid GRAPHQL_APQ_CACHE_SIZE %q", os.Getenv("GRAPHQL_APQ_CACHE_SIZE"))
+	}
+	graphQLPersistedQueriesOnly, err := strconv.ParseBool(getEnv("GRAPHQL_PERSISTED_QUERIES_ONLY", "false"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid GRAPHQL_PERSISTED_QUERIES_ONLY %q", os.Getenv("GRAPHQL_PERSISTED_QUERIES_ONLY"))
+	}
+
 	cfg := &Config{
-		AppEnv:           os.Getenv("APP_ENV"),
+		AppEnv:           appEnv,
 		DatabaseHost:     os.Getenv("DATABASE_HOST"),
 		DatabasePort:     dbPort,
 		DatabaseUser:     os.Getenv("DATABASE_USER"),
 		DatabasePassword: os.Getenv("DATABASE_PASSWORD"),
 		DatabaseDBName:   os.Getenv("DATABASE_DBNAME"),
 		DatabaseSSLMode:  os.Getenv("DATABASE_SSLMODE"),
+		HTTPPort:         getEnv("PORT", "8081"),
+		GRPCPort:         getEnv("GRPC_PORT", "50051"),
+		ShutdownTimeout:  shutdownTimeout,
+		RateLimitRPS:     rateLimitRPS,
+		RateLimitBurst:   rateLimitBurst,
+		TrustedProxies:   strings.FieldsFunc(os.Getenv("TRUSTED_PROXIES"), func(r rune) bool { return r == ',' || r == ' ' }),
+		JWTSecret:        os.Getenv("JWT_SECRET"),
+		JWTJWKSFile:      os.Getenv("JWT_JWKS_FILE"),
+		JWTIssuer:        os.Getenv("JWT_ISSUER"),
+		JWTAudience:      os.Getenv("JWT_AUDIENCE"),
+		WSSEUsers:        wsseUsers,
+		WSSEScopes:       strings.Fields(getEnv("WSSE_SCOPES", "movies:read")),
+		SOAPPublicURL:    os.Getenv("SOAP_PUBLIC_URL"),
+		PosterDir:        getEnv("POSTER_DIR", "data/posters"),
+
+		GraphQLIntrospection:   graphQLIntrospection,
+		GraphQLComplexityLimit: graphQLComplexityLimit,
+		GraphQLDepthLimit:      graphQLDepthLimit,
+
+		GraphQLAPQCacheSize:         graphQLAPQCacheSize,
+		GraphQLPersistedQueriesOnly: graphQLPersistedQueriesOnly,
 	}
 
 	if cfg.DatabaseHost == "" || cfg.DatabasePort == 0 || cfg.DatabaseUser == "" || cfg.DatabasePassword == "" || cfg.DatabaseDBName == "" || cfg.DatabaseSSLMode == "" {
@@ -39,3 +139,27 @@ func LoadConfig() (*Config, error) {
 
 	return cfg, nil
 }
+
+// parseWSSEUsers reads "user:password,user2:password2".
+func parseWSSEUsers(v string) (map[string]string, error) {
+	users := make(map[string]string)
+	for _, entry := range strings.Split(v, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		user, password, ok := strings.Cut(entry, ":")
+		if !ok || user == "" || password == "" {
+			return nil, fmt.Errorf("invalid WSSE_USERS entry %q", entry)
+		}
+		users[user] = password
+	}
+	return users, nil
+}
+
+func getEnv(key, fallback string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return fallback
+}
@@ -0,0 +1,46 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadConfig_AppEnv(t *testing.T) {
+	tests := []struct {
+		name              string
+		appEnv            string
+		wantIntrospection bool
+		wantErr           bool
+	}{
+		{name: "defaults to production", appEnv: "", wantIntrospection: false},
+		{name: "development", appEnv: "development", wantIntrospection: true},
+		{name: "unknown value", appEnv: "dev", wantErr: true},
+	}
+
+	for key, value := range map[string]string{
+		"DATABASE_HOST":     "localhost",
+		"DATABASE_PORT":     "5432",
+		"DATABASE_USER":     "user",
+		"DATABASE_PASSWORD": "password",
+		"DATABASE_DBNAME":   "db",
+		"DATABASE_SSLMODE":  "disable",
+	} {
+		t.Setenv(key, value)
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("APP_ENV", tt.appEnv)
+			t.Setenv("GRAPHQL_INTROSPECTION", "")
+			cfg, err := LoadConfig()
+			if tt.wantErr {
+				assert.EqualError(t, err, `invalid APP_ENV "dev", must be "production" or "development"`)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantIntrospection, cfg.GraphQLIntrospection)
+		})
+	}
+}
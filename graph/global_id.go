@@ -0,0 +1,39 @@
+package graph
+
+import (
+	"encoding/base64"
+	"strconv"
+	"strings"
+
+	"github.com/sorrawichYooboon/go-protocol-api-style/graph/model"
+)
+
+const movieTypeName = "Movie"
+
+// Global IDs are "<type>:<id>" in base64, as graphql-relay encodes them, so
+// node(id:) can tell which type to fetch. Clients treat them as opaque.
+func toGlobalID(typeName string, id int64) string {
+	return base64.StdEncoding.EncodeToString([]byte(typeName + ":" + strconv.FormatInt(id, 10)))
+}
+
+func fromGlobalID(globalID string) (string, int64, bool) {
+	raw, err := base64.StdEncoding.DecodeString(globalID)
+	if err != nil {
+		return "", 0, false
+	}
+	typeName, idStr, ok := strings.Cut(string(raw), ":")
+	if !ok {
+		return "", 0, false
+	}
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		return "", 0, false
+	}
+	return typeName, id, true
+}
+
+// invalidGlobalID reports an ID argument that does not name a Movie, at
+// field, its path within the arguments.
+func invalidGlobalID(field string) error {
+	return model.InvalidInputError(field, "must be an ID returned by this API")
+}
@@ -0,0 +1,45 @@
+package graph
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGlobalID(t *testing.T) {
+	id := toGlobalID(movieTypeName, 42)
+	assert.Equal(t, "TW92aWU6NDI=", id)
+
+	typeName, idInt, ok := fromGlobalID(id)
+	require.True(t, ok)
+	assert.Equal(t, movieTypeName, typeName)
+	assert.Equal(t, int64(42), idInt)
+}
+
+func TestParseMovieID(t *testing.T) {
+	tests := []struct {
+		name    string
+		id      string
+		want    int64
+		wantErr bool
+	}{
+		{name: "movie global ID", id: toGlobalID(movieTypeName, 42), want: 42},
+		{name: "bare database id", id: "42", want: 42},
+		{name: "global ID of another type", id: toGlobalID("Actor", 42), wantErr: true},
+		{name: "not base64", id: "Movie:42", wantErr: true},
+		{name: "no separator", id: "NDI=", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseMovieID(tt.id)
+			if tt.wantErr {
+				assert.EqualError(t, err, invalidGlobalID("id").Error())
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,24 @@
+package graph
+
+import (
+	"github.com/sorrawichYooboon/go-protocol-api-style/graph/model"
+	"github.com/sorrawichYooboon/go-protocol-api-style/internal/domain"
+)
+
+func toHealthModel(report *domain.HealthReport) *model.Health {
+	health := &model.Health{
+		Status: model.HealthStatus(report.Status),
+		Checks: make([]*model.HealthCheck, 0, len(report.Checks)),
+	}
+	for _, check := range report.Checks {
+		healthCheck := &model.HealthCheck{
+			Name:   check.Name,
+			Status: model.HealthStatus(check.Status),
+		}
+		if check.Error != "" {
+			healthCheck.Error = &check.Error
+		}
+		health.Checks = append(health.Checks, healthCheck)
+	}
+	return health
+}
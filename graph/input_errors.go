@@ -0,0 +1,31 @@
+package graph
+
+import (
+	"errors"
+
+	"github.com/sorrawichYooboon/go-protocol-api-style/graph/model"
+	"github.com/sorrawichYooboon/go-protocol-api-style/internal/usecase"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// inputErrors reports each violation of an INVALID_ARGUMENT error as its own
+// GraphQL error. extensions.field is the path of the offending input field,
+// e.g. "input.title", so clients can attach the message to a form control.
+// Violations are resolved relative to argument; pass "" when they already
+// name an argument. Any other error is returned unchanged.
+func inputErrors(err error, argument string) error {
+	var ucErr *usecase.Error
+	if !errors.As(err, &ucErr) || ucErr.Code != usecase.ErrCodeInvalidArgument || len(ucErr.Violations) == 0 {
+		return err
+	}
+
+	list := make(gqlerror.List, 0, len(ucErr.Violations))
+	for _, v := range ucErr.Violations {
+		field := v.Field
+		if argument != "" {
+			field = argument + "." + field
+		}
+		list = append(list, model.InvalidInputError(field, v.Description))
+	}
+	return list
+}
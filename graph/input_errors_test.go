@@ -0,0 +1,39 @@
+package graph
+
+import (
+	"testing"
+
+	"github.com/sorrawichYooboon/go-protocol-api-style/internal/usecase"
+	"github.com/stretchr/testify/assert"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+func TestInputErrors(t *testing.T) {
+	err := usecase.NewInvalidArgumentError("invalid movie",
+		usecase.FieldViolation{Field: "title", Description: "must not be empty"},
+		usecase.FieldViolation{Field: "releaseDate", Description: "must be a date in YYYY-MM-DD format"},
+	)
+
+	assert.Equal(t, gqlerror.List{
+		{Message: "input.title must not be empty", Extensions: map[string]any{"code": "INVALID_ARGUMENT", "field": "input.title"}},
+		{Message: "input.releaseDate must be a date in YYYY-MM-DD format", Extensions: map[string]any{"code": "INVALID_ARGUMENT", "field": "input.releaseDate"}},
+	}, inputErrors(err, "input"))
+}
+
+func TestInputErrors_passThrough(t *testing.T) {
+	notFound := usecase.NewNotFoundError("movie %d not found", 1)
+	assert.Equal(t, error(notFound), inputErrors(notFound, "input"))
+
+	noViolations := usecase.NewInvalidArgumentError("invalid cursor")
+	assert.Equal(t, error(noViolations), inputErrors(noViolations, "input"))
+}
+
+func TestInputErrors_moviesConnectionArguments(t *testing.T) {
+	err := usecase.NewInvalidArgumentError("invalid filter",
+		usecase.FieldViolation{Field: "releasedFrom", Description: "must not be after the end of the date range"},
+	)
+
+	assert.Equal(t, gqlerror.List{
+		{Message: "filter.releasedFrom must not be after the end of the date range", Extensions: map[string]any{"code": "INVALID_ARGUMENT", "field": "filter.releasedFrom"}},
+	}, inputErrors(usecase.RenameViolations(err, moviesConnectionArgs), ""))
+}
@@ -0,0 +1,29 @@
+package model
+
+import (
+	"context"
+	"io"
+	"strconv"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/sorrawichYooboon/go-protocol-api-style/internal/domain"
+)
+
+// MarshalDate and UnmarshalDate bind the Date scalar to domain.Date.
+func MarshalDate(d domain.Date) graphql.ContextMarshaler {
+	return graphql.ContextWriterFunc(func(_ context.Context, w io.Writer) error {
+		_, err := io.WriteString(w, strconv.Quote(d.String()))
+		return err
+	})
+}
+
+// UnmarshalDate errors are reported like any other invalid input, with the
+// path of the offending argument in extensions.field.
+func UnmarshalDate(ctx context.Context, v any) (domain.Date, error) {
+	s, _ := v.(string)
+	d, err := domain.ParseDate(s)
+	if err != nil {
+		return domain.Date{}, InvalidInputError(argumentPath(ctx), "must be a date in YYYY-MM-DD format")
+	}
+	return d, nil
+}
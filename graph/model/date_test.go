@@ -0,0 +1,24 @@
+package model
+
+import (
+	"context"
+	"testing"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/sorrawichYooboon/go-protocol-api-style/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnmarshalDate(t *testing.T) {
+	ctx := graphql.WithPathContext(context.Background(), graphql.NewPathWithField("input"))
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("releaseDate"))
+
+	got, err := UnmarshalDate(ctx, "2021-10-22")
+	require.NoError(t, err)
+	assert.Equal(t, domain.Date{Year: 2021, Month: 10, Day: 22}, got)
+
+	_, err = UnmarshalDate(ctx, "2021-02-30")
+	assert.Equal(t, InvalidInputError("input.releaseDate", "must be a date in YYYY-MM-DD format"), err)
+	assert.Equal(t, map[string]any{"code": "INVALID_ARGUMENT", "field": "input.releaseDate"}, InvalidInputError("input.releaseDate", "x").Extensions)
+}
@@ -0,0 +1,41 @@
+package model
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/sorrawichYooboon/go-protocol-api-style/internal/usecase"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// InvalidInputError reports one invalid argument or input field. field is
+// its path within the arguments, e.g. "input.title", so clients can attach
+// the message to a form control.
+func InvalidInputError(field, description string) *gqlerror.Error {
+	return &gqlerror.Error{
+		Message: field + " " + description,
+		Extensions: map[string]any{
+			"code":  string(usecase.ErrCodeInvalidArgument),
+			"field": field,
+		},
+	}
+}
+
+// argumentPath is the path of the value being unmarshaled within the
+// arguments of its field, e.g. "input.releaseDate" or "ids.2".
+func argumentPath(ctx context.Context) string {
+	var parts []string
+	for p := graphql.GetPathContext(ctx); p != nil; p = p.Parent {
+		if p.Index != nil {
+			parts = append(parts, strconv.Itoa(*p.Index))
+		} else if p.Field != nil {
+			parts = append(parts, *p.Field)
+		}
+	}
+	for i, j := 0, len(parts)-1; i < j; i, j = i+1, j-1 {
+		parts[i], parts[j] = parts[j], parts[i]
+	}
+	return strings.Join(parts, ".")
+}
@@ -2,12 +2,320 @@
 
 package model
 
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/sorrawichYooboon/go-protocol-api-style/internal/domain"
+)
+
+// An object that can be refetched with node(id:). Its id is a global ID, opaque
+// and unique across all types.
+type Node interface {
+	IsNode()
+	GetID() string
+}
+
+type Health struct {
+	Status HealthStatus   `json:"status"`
+	Checks []*HealthCheck `json:"checks"`
+}
+
+type HealthCheck struct {
+	Name   string       `json:"name"`
+	Status HealthStatus `json:"status"`
+	Error  *string      `json:"error,omitempty"`
+}
+
 type Movie struct {
 	ID          string `json:"id"`
 	Title       string `json:"title"`
 	Description string `json:"description"`
-	ReleaseDate string `json:"releaseDate"`
+	// Null when no release date is stored for the movie.
+	ReleaseDate *domain.Date `json:"releaseDate,omitempty"`
+}
+
+func (Movie) IsNode()            {}
+func (this Movie) GetID() string { return this.ID }
+
+type MovieChangedEvent struct {
+	Type MovieChangeType `json:"type"`
+	// For DELETED, the movie as it was before it was removed.
+	Movie *Movie `json:"movie"`
+}
+
+type MovieConnection struct {
+	Edges    []*MovieEdge `json:"edges"`
+	PageInfo *PageInfo    `json:"pageInfo"`
+}
+
+type MovieEdge struct {
+	Cursor string `json:"cursor"`
+	Node   *Movie `json:"node"`
+}
+
+// Narrows moviesConnection. Omitted fields match every movie.
+type MovieFilter struct {
+	// Case-insensitive substring of the title, at most 255 characters.
+	TitleContains *string `json:"titleContains,omitempty"`
+	// Inclusive lower bound on the release date.
+	ReleasedFrom *domain.Date `json:"releasedFrom,omitempty"`
+	// Inclusive upper bound on the release date.
+	ReleasedTo *domain.Date `json:"releasedTo,omitempty"`
+}
+
+type MovieInput struct {
+	// Must not be blank and at most 255 characters.
+	Title       string      `json:"title"`
+	Description string      `json:"description"`
+	ReleaseDate domain.Date `json:"releaseDate"`
+}
+
+type MovieOrder struct {
+	Field     MovieSortField `json:"field"`
+	Direction *SortDirection `json:"direction,omitempty"`
+}
+
+// Mutations require the movies:write scope. An invalid input gives one
+// INVALID_ARGUMENT error per offending field, with extensions.field set to its
+// path, e.g. "input.title".
+type Mutation struct {
+}
+
+type PageInfo struct {
+	HasNextPage bool    `json:"hasNextPage"`
+	EndCursor   *string `json:"endCursor,omitempty"`
 }
 
 type Query struct {
 }
+
+type Subscription struct {
+}
+
+type HealthStatus string
+
+const (
+	HealthStatusUp   HealthStatus = "UP"
+	HealthStatusDown HealthStatus = "DOWN"
+)
+
+var AllHealthStatus = []HealthStatus{
+	HealthStatusUp,
+	HealthStatusDown,
+}
+
+func (e HealthStatus) IsValid() bool {
+	switch e {
+	case HealthStatusUp, HealthStatusDown:
+		return true
+	}
+	return false
+}
+
+func (e HealthStatus) String() string {
+	return string(e)
+}
+
+func (e *HealthStatus) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = HealthStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid HealthStatus", str)
+	}
+	return nil
+}
+
+func (e HealthStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *HealthStatus) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e HealthStatus) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type MovieChangeType string
+
+const (
+	MovieChangeTypeCreated MovieChangeType = "CREATED"
+	MovieChangeTypeUpdated MovieChangeType = "UPDATED"
+	MovieChangeTypeDeleted MovieChangeType = "DELETED"
+)
+
+var AllMovieChangeType = []MovieChangeType{
+	MovieChangeTypeCreated,
+	MovieChangeTypeUpdated,
+	MovieChangeTypeDeleted,
+}
+
+func (e MovieChangeType) IsValid() bool {
+	switch e {
+	case MovieChangeTypeCreated, MovieChangeTypeUpdated, MovieChangeTypeDeleted:
+		return true
+	}
+	return false
+}
+
+func (e MovieChangeType) String() string {
+	return string(e)
+}
+
+func (e *MovieChangeType) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = MovieChangeType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid MovieChangeType", str)
+	}
+	return nil
+}
+
+func (e MovieChangeType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *MovieChangeType) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e MovieChangeType) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type MovieSortField string
+
+const (
+	MovieSortFieldID    MovieSortField = "ID"
+	MovieSortFieldTitle MovieSortField = "TITLE"
+	// Movies without a release date come last in either direction.
+	MovieSortFieldReleaseDate MovieSortField = "RELEASE_DATE"
+)
+
+var AllMovieSortField = []MovieSortField{
+	MovieSortFieldID,
+	MovieSortFieldTitle,
+	MovieSortFieldReleaseDate,
+}
+
+func (e MovieSortField) IsValid() bool {
+	switch e {
+	case MovieSortFieldID, MovieSortFieldTitle, MovieSortFieldReleaseDate:
+		return true
+	}
+	return false
+}
+
+func (e MovieSortField) String() string {
+	return string(e)
+}
+
+func (e *MovieSortField) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = MovieSortField(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid MovieSortField", str)
+	}
+	return nil
+}
+
+func (e MovieSortField) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *MovieSortField) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e MovieSortField) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type SortDirection string
+
+const (
+	SortDirectionAsc  SortDirection = "ASC"
+	SortDirectionDesc SortDirection = "DESC"
+)
+
+var AllSortDirection = []SortDirection{
+	SortDirectionAsc,
+	SortDirectionDesc,
+}
+
+func (e SortDirection) IsValid() bool {
+	switch e {
+	case SortDirectionAsc, SortDirectionDesc:
+		return true
+	}
+	return false
+}
+
+func (e SortDirection) String() string {
+	return string(e)
+}
+
+func (e *SortDirection) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = SortDirection(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid SortDirection", str)
+	}
+	return nil
+}
+
+func (e SortDirection) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *SortDirection) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e SortDirection) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,104 @@
+package graph
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/sorrawichYooboon/go-protocol-api-style/internal/domain"
+	"github.com/sorrawichYooboon/go-protocol-api-style/internal/usecase"
+)
+
+// movieLoaderWait is how long the first lookup of a batch waits for sibling
+// fields, which gqlgen resolves concurrently, to join it.
+const movieLoaderWait = 2 * time.Millisecond
+
+type movieLoaderKey struct{}
+
+// WithMovieLoader attaches a loader that coalesces the movie lookups of one
+// operation into GetMoviesByIDs calls. Attach a new one per operation so
+// results are never shared between requests or principals.
+func WithMovieLoader(ctx context.Context, movieUsecase usecase.MovieUsecase) context.Context {
+	return context.WithValue(ctx, movieLoaderKey{}, &movieLoader{
+		fetch:    movieUsecase.GetMoviesByIDs,
+		wait:     movieLoaderWait,
+		maxBatch: usecase.MaxBatchSize,
+	})
+}
+
+// loadMovie returns the movie with id, batched with the other lookups of the
+// operation when a loader is attached.
+func (r *Resolver) loadMovie(ctx context.Context, id int64) (*domain.Movie, error) {
+	loader, ok := ctx.Value(movieLoaderKey{}).(*movieLoader)
+	if !ok {
+		return r.MovieUsecase.GetMovieByID(ctx, id)
+	}
+	return loader.load(ctx, id)
+}
+
+type movieLoader struct {
+	fetch    func(ctx context.Context, ids []int64) (map[int64]*domain.Movie, error)
+	wait     time.Duration
+	maxBatch int
+
+	mu    sync.Mutex
+	batch *movieBatch
+}
+
+type movieBatch struct {
+	ids    []int64
+	once   sync.Once
+	done   chan struct{}
+	movies map[int64]*domain.Movie
+	err    error
+}
+
+func (l *movieLoader) load(ctx context.Context, id int64) (*domain.Movie, error) {
+	l.mu.Lock()
+	b := l.batch
+	if b == nil {
+		b = &movieBatch{done: make(chan struct{})}
+		l.batch = b
+		time.AfterFunc(l.wait, func() { l.dispatch(ctx, b) })
+	}
+	if !slices.Contains(b.ids, id) {
+		b.ids = append(b.ids, id)
+	}
+	full := len(b.ids) >= l.maxBatch
+	l.mu.Unlock()
+
+	if full {
+		l.dispatch(ctx, b)
+	}
+	select {
+	case <-b.done:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	if b.err != nil {
+		return nil, b.err
+	}
+	movie, ok := b.movies[id]
+	if !ok {
+		return nil, usecase.NewNotFoundError("movie %d not found", id)
+	}
+	return movie, nil
+}
+
+// dispatch fetches b once, whether it filled up or its wait elapsed. Lookups
+// after that start a new batch.
+func (l *movieLoader) dispatch(ctx context.Context, b *movieBatch) {
+	b.once.Do(func() {
+		l.mu.Lock()
+		if l.batch == b {
+			l.batch = nil
+		}
+		ids := b.ids
+		l.mu.Unlock()
+
+		b.movies, b.err = l.fetch(ctx, ids)
+		close(b.done)
+	})
+}
@@ -0,0 +1,89 @@
+package graph
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sorrawichYooboon/go-protocol-api-style/internal/domain"
+	"github.com/sorrawichYooboon/go-protocol-api-style/internal/usecase"
+	"github.com/stretchr/testify/assert"
+)
+
+type recordingFetch struct {
+	mu      sync.Mutex
+	batches [][]int64
+	err     error
+}
+
+func (f *recordingFetch) fetch(_ context.Context, ids []int64) (map[int64]*domain.Movie, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	batch := append([]int64(nil), ids...)
+	sort.Slice(batch, func(i, j int) bool { return batch[i] < batch[j] })
+	f.batches = append(f.batches, batch)
+	if f.err != nil {
+		return nil, f.err
+	}
+	movies := make(map[int64]*domain.Movie)
+	for _, id := range ids {
+		if id%2 == 0 {
+			movies[id] = &domain.Movie{ID: id}
+		}
+	}
+	return movies, nil
+}
+
+func loadConcurrently(loader *movieLoader, ids ...int64) ([]*domain.Movie, []error) {
+	movies := make([]*domain.Movie, len(ids))
+	errs := make([]error, len(ids))
+	var wg sync.WaitGroup
+	for i, id := range ids {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			movies[i], errs[i] = loader.load(context.Background(), id)
+		}()
+	}
+	wg.Wait()
+	return movies, errs
+}
+
+func TestMovieLoader(t *testing.T) {
+	f := &recordingFetch{}
+	loader := &movieLoader{fetch: f.fetch, wait: 100 * time.Millisecond, maxBatch: 100}
+
+	movies, errs := loadConcurrently(loader, 2, 4, 2, 3)
+
+	assert.Equal(t, [][]int64{{2, 3, 4}}, f.batches)
+	assert.Equal(t, []*domain.Movie{{ID: 2}, {ID: 4}, {ID: 2}, nil}, movies)
+	assert.Equal(t, []error{nil, nil, nil, usecase.NewNotFoundError("movie 3 not found")}, errs)
+
+	// A lookup after the batch was sent starts a new one.
+	movie, err := loader.load(context.Background(), 6)
+	assert.NoError(t, err)
+	assert.Equal(t, &domain.Movie{ID: 6}, movie)
+	assert.Equal(t, [][]int64{{2, 3, 4}, {6}}, f.batches)
+}
+
+func TestMovieLoader_maxBatch(t *testing.T) {
+	f := &recordingFetch{}
+	loader := &movieLoader{fetch: f.fetch, wait: time.Hour, maxBatch: 2}
+
+	_, errs := loadConcurrently(loader, 2, 4)
+
+	assert.Equal(t, []error{nil, nil}, errs)
+	assert.Equal(t, [][]int64{{2, 4}}, f.batches)
+}
+
+func TestMovieLoader_error(t *testing.T) {
+	f := &recordingFetch{err: assert.AnError}
+	loader := &movieLoader{fetch: f.fetch, wait: time.Millisecond, maxBatch: 100}
+
+	_, errs := loadConcurrently(loader, 2, 4)
+
+	assert.Equal(t, []error{assert.AnError, assert.AnError}, errs)
+	assert.Len(t, f.batches, 1)
+}
@@ -0,0 +1,109 @@
+package graph
+
+import (
+	"strconv"
+
+	"github.com/sorrawichYooboon/go-protocol-api-style/graph/model"
+	"github.com/sorrawichYooboon/go-protocol-api-style/internal/domain"
+)
+
+// parseMovieID accepts a Movie global ID, or the bare database id that
+// clients received before global IDs were introduced.
+func parseMovieID(id string) (int64, error) {
+	if typeName, idInt, ok := fromGlobalID(id); ok && typeName == movieTypeName {
+		return idInt, nil
+	}
+	if idInt, err := strconv.ParseInt(id, 10, 64); err == nil {
+		return idInt, nil
+	}
+	return 0, invalidGlobalID("id")
+}
+
+func toMovieModel(m *domain.Movie) *model.Movie {
+	return &model.Movie{
+		ID:          toGlobalID(movieTypeName, m.ID),
+		Title:       m.Title,
+		Description: m.Description,
+		ReleaseDate: toDateModel(m.ReleaseDate),
+	}
+}
+
+// toDateModel maps a missing date to null rather than an empty string,
+// which is not a valid Date.
+func toDateModel(d domain.Date) *domain.Date {
+	if d.IsZero() {
+		return nil
+	}
+	return &d
+}
+
+func toMovieDomain(id int64, input model.MovieInput) *domain.Movie {
+	return &domain.Movie{
+		ID:          id,
+		Title:       input.Title,
+		Description: input.Description,
+		ReleaseDate: input.ReleaseDate,
+	}
+}
+
+// moviesConnectionArgs maps usecase field names to their paths within the
+// arguments of moviesConnection.
+var moviesConnectionArgs = map[string]string{
+	"titleContains": "filter.titleContains",
+	"releasedFrom":  "filter.releasedFrom",
+	"releasedTo":    "filter.releasedTo",
+	"pageSize":      "first",
+	"cursor":        "after",
+}
+
+func toMovieFilter(filter *model.MovieFilter) domain.MovieFilter {
+	var f domain.MovieFilter
+	if filter == nil {
+		return f
+	}
+	if filter.TitleContains != nil {
+		f.TitleContains = *filter.TitleContains
+	}
+	if filter.ReleasedFrom != nil {
+		f.ReleasedFrom = *filter.ReleasedFrom
+	}
+	if filter.ReleasedTo != nil {
+		f.ReleasedTo = *filter.ReleasedTo
+	}
+	return f
+}
+
+func toMovieSort(orderBy *model.MovieOrder) domain.MovieSort {
+	if orderBy == nil {
+		return domain.MovieSort{}
+	}
+	return domain.MovieSort{
+		Field:      domain.MovieSortField(orderBy.Field),
+		Descending: orderBy.Direction != nil && *orderBy.Direction == model.SortDirectionDesc,
+	}
+}
+
+func toMovieChangedEvent(event domain.MovieEvent) *model.MovieChangedEvent {
+	return &model.MovieChangedEvent{
+		Type:  model.MovieChangeType(event.Type),
+		Movie: toMovieModel(&event.Movie),
+	}
+}
+
+func toMovieConnection(page *domain.MoviePage) *model.MovieConnection {
+	conn := &model.MovieConnection{
+		Edges:    make([]*model.MovieEdge, 0, len(page.Edges)),
+		PageInfo: &model.PageInfo{HasNextPage: page.NextCursor != ""},
+	}
+	for i := range page.Edges {
+		conn.Edges = append(conn.Edges, &model.MovieEdge{
+			Cursor: page.Edges[i].Cursor,
+			Node:   toMovieModel(&page.Edges[i].Movie),
+		})
+	}
+	if len(page.Edges) > 0 {
+		endCursor := page.Edges[len(page.Edges)-1].Cursor
+		conn.PageInfo.EndCursor = &endCursor
+	}
+	return conn
+}
@@ -0,0 +1,16 @@
+package graph
+
+import (
+	"testing"
+
+	"github.com/sorrawichYooboon/go-protocol-api-style/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToMovieModel_ReleaseDate(t *testing.T) {
+	dune := toMovieModel(&domain.Movie{ID: 1, ReleaseDate: domain.Date{Year: 2021, Month: 10, Day: 22}})
+	assert.Equal(t, &domain.Date{Year: 2021, Month: 10, Day: 22}, dune.ReleaseDate)
+
+	undated := toMovieModel(&domain.Movie{ID: 2})
+	assert.Nil(t, undated.ReleaseDate, "a missing date is null, not an invalid empty Date")
+}
@@ -0,0 +1,146 @@
+package graph
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sorrawichYooboon/go-protocol-api-style/graph/model"
+	"github.com/sorrawichYooboon/go-protocol-api-style/internal/domain"
+	mockRepo "github.com/sorrawichYooboon/go-protocol-api-style/internal/mock"
+	"github.com/sorrawichYooboon/go-protocol-api-style/internal/usecase"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestMutationResolver_Movies(t *testing.T) {
+	mockMovieRepo := mockRepo.NewMovieRepository(t)
+	mockMovieEventBus := mockRepo.NewMovieEventBus(t)
+
+	clearAllMock := func() {
+		mockMovieRepo.ClearAll()
+		mockMovieEventBus.ClearAll()
+	}
+
+	resolver := &mutationResolver{&Resolver{MovieUsecase: usecase.NewMovieUsecase(mockMovieRepo, nil, mockMovieEventBus)}}
+	ctx := usecase.WithPrincipal(context.Background(), &domain.Principal{
+		Subject: "apikey:1",
+		Name:    "test",
+		Scopes:  []string{usecase.ScopeMoviesRead, usecase.ScopeMoviesWrite},
+	})
+
+	releaseDate := domain.Date{Year: 2021, Month: time.October, Day: 22}
+	dune := &domain.Movie{ID: 4, Title: "Dune", ReleaseDate: releaseDate}
+	duneInput := model.MovieInput{Title: "Dune", ReleaseDate: releaseDate}
+	duneID := toGlobalID(movieTypeName, 4)
+
+	tests := []struct {
+		name string
+		call func() (*model.Movie, error)
+
+		wantServiceOrRepoCallWithAndResponse func()
+		wantError                            string
+		wantMovie                            *model.Movie
+	}{
+		{
+			name: "create with empty title",
+			call: func() (*model.Movie, error) {
+				return resolver.CreateMovie(ctx, model.MovieInput{Title: " ", ReleaseDate: releaseDate})
+			},
+			wantError: "input.title must not be empty",
+		},
+		{
+			name: "create",
+			call: func() (*model.Movie, error) {
+				return resolver.CreateMovie(ctx, duneInput)
+			},
+			wantServiceOrRepoCallWithAndResponse: func() {
+				mockMovieRepo.On("Create", mock.Anything, &domain.Movie{Title: "Dune", ReleaseDate: releaseDate}).Return(dune, nil)
+				mockMovieEventBus.On("Publish", mock.Anything).Return()
+			},
+			wantMovie: toMovieModel(dune),
+		},
+		{
+			name: "update with invalid id",
+			call: func() (*model.Movie, error) {
+				return resolver.UpdateMovie(ctx, "not-an-id", duneInput)
+			},
+			wantError: "id must be an ID returned by this API",
+		},
+		{
+			name: "update without release date",
+			call: func() (*model.Movie, error) {
+				return resolver.UpdateMovie(ctx, duneID, model.MovieInput{Title: "Dune"})
+			},
+			wantError: "input.releaseDate must not be empty",
+		},
+		{
+			name: "update missing movie",
+			call: func() (*model.Movie, error) {
+				return resolver.UpdateMovie(ctx, toGlobalID(movieTypeName, 7), duneInput)
+			},
+			wantServiceOrRepoCallWithAndResponse: func() {
+				mockMovieRepo.On("Update", mock.Anything, mock.Anything).Return(nil, nil)
+			},
+			wantError: "movie 7 not found",
+		},
+		{
+			name: "update",
+			call: func() (*model.Movie, error) {
+				return resolver.UpdateMovie(ctx, duneID, duneInput)
+			},
+			wantServiceOrRepoCallWithAndResponse: func() {
+				mockMovieRepo.On("Update", mock.Anything, dune).Return(dune, nil)
+				mockMovieEventBus.On("Publish", mock.Anything).Return()
+			},
+			wantMovie: toMovieModel(dune),
+		},
+		{
+			name: "delete with invalid id",
+			call: func() (*model.Movie, error) {
+				return resolver.DeleteMovie(ctx, "not-an-id")
+			},
+			wantError: "id must be an ID returned by this API",
+		},
+		{
+			name: "delete missing movie",
+			call: func() (*model.Movie, error) {
+				return resolver.DeleteMovie(ctx, "7")
+			},
+			wantServiceOrRepoCallWithAndResponse: func() {
+				mockMovieRepo.On("Delete", mock.Anything, int64(7)).Return(nil, nil)
+			},
+			wantError: "movie 7 not found",
+		},
+		{
+			name: "delete",
+			call: func() (*model.Movie, error) {
+				return resolver.DeleteMovie(ctx, duneID)
+			},
+			wantServiceOrRepoCallWithAndResponse: func() {
+				mockMovieRepo.On("Delete", mock.Anything, int64(4)).Return(dune, nil)
+				mockMovieEventBus.On("Publish", mock.Anything).Return()
+			},
+			wantMovie: toMovieModel(dune),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			defer clearAllMock()
+
+			if test.wantServiceOrRepoCallWithAndResponse != nil {
+				test.wantServiceOrRepoCallWithAndResponse()
+			}
+
+			movie, err := test.call()
+
+			if test.wantError != "" {
+				assert.ErrorContains(t, err, test.wantError)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, test.wantMovie, movie)
+		})
+	}
+}
@@ -0,0 +1,10 @@
+package graph
+
+import _ "embed"
+
+// PersistedQueries is the allowlist used when only persisted queries are
+// accepted: a JSON object mapping the sha256 hash of each query to its text.
+// Clients build their requests from the same file.
+//
+//go:embed persisted_queries.json
+var PersistedQueries []byte
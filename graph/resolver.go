@@ -3,5 +3,6 @@ package graph
 import "github.com/sorrawichYooboon/go-protocol-api-style/internal/usecase"
 
 type Resolver struct {
-	MovieUsecase usecase.MovieUsecase
+	MovieUsecase  usecase.MovieUsecase
+	HealthUsecase usecase.HealthUsecase
 }
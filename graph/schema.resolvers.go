@@ -8,46 +8,176 @@ import (
 	"context"
 	"strconv"
 
+	"github.com/99designs/gqlgen/graphql"
 	"github.com/sorrawichYooboon/go-protocol-api-style/graph/model"
+	"github.com/sorrawichYooboon/go-protocol-api-style/internal/usecase"
 )
 
+// CreateMovie is the resolver for the createMovie field.
+func (r *mutationResolver) CreateMovie(ctx context.Context, input model.MovieInput) (*model.Movie, error) {
+	movie, err := r.Resolver.MovieUsecase.CreateMovie(ctx, toMovieDomain(0, input))
+	if err != nil {
+		return nil, inputErrors(err, "input")
+	}
+	return toMovieModel(movie), nil
+}
+
+// UpdateMovie is the resolver for the updateMovie field.
+func (r *mutationResolver) UpdateMovie(ctx context.Context, id string, input model.MovieInput) (*model.Movie, error) {
+	idInt, err := parseMovieID(id)
+	if err != nil {
+		return nil, err
+	}
+	movie, err := r.Resolver.MovieUsecase.UpdateMovie(ctx, toMovieDomain(idInt, input))
+	if err != nil {
+		return nil, inputErrors(err, "input")
+	}
+	return toMovieModel(movie), nil
+}
+
+// DeleteMovie is the resolver for the deleteMovie field.
+func (r *mutationResolver) DeleteMovie(ctx context.Context, id string) (*model.Movie, error) {
+	idInt, err := parseMovieID(id)
+	if err != nil {
+		return nil, err
+	}
+	movie, err := r.Resolver.MovieUsecase.DeleteMovie(ctx, idInt)
+	if err != nil {
+		return nil, err
+	}
+	return toMovieModel(movie), nil
+}
+
 // Movies is the resolver for the movies field.
 func (r *queryResolver) Movies(ctx context.Context) ([]*model.Movie, error) {
-	movies, err := r.Resolver.MovieUsecase.GetAllMovies()
+	movies, err := r.Resolver.MovieUsecase.GetAllMovies(ctx)
 	if err != nil {
 		return nil, err
 	}
 	result := make([]*model.Movie, len(movies))
 	for i := range movies {
-		result[i] = &model.Movie{
-			ID:          strconv.FormatInt(movies[i].ID, 10),
-			Title:       movies[i].Title,
-			Description: movies[i].Description,
-			ReleaseDate: movies[i].ReleaseDate,
-		}
+		result[i] = toMovieModel(&movies[i])
 	}
 	return result, nil
 }
 
+// MoviesConnection is the resolver for the moviesConnection field.
+func (r *queryResolver) MoviesConnection(ctx context.Context, first *int32, after *string, filter *model.MovieFilter, orderBy *model.MovieOrder) (*model.MovieConnection, error) {
+	pageSize := 0
+	if first != nil {
+		pageSize = int(*first)
+	}
+	cursor := ""
+	if after != nil {
+		cursor = *after
+	}
+	page, err := r.Resolver.MovieUsecase.ListMovies(ctx, toMovieFilter(filter), toMovieSort(orderBy), pageSize, cursor)
+	if err != nil {
+		return nil, inputErrors(usecase.RenameViolations(err, moviesConnectionArgs), "")
+	}
+	return toMovieConnection(page), nil
+}
+
 // Movie is the resolver for the movie field.
 func (r *queryResolver) Movie(ctx context.Context, id string) (*model.Movie, error) {
-	idInt, err := strconv.ParseInt(id, 10, 64)
+	idInt, err := parseMovieID(id)
 	if err != nil {
 		return nil, err
 	}
-	movie, err := r.Resolver.MovieUsecase.GetMovieByID(idInt)
+	movie, err := r.loadMovie(ctx, idInt)
 	if err != nil {
 		return nil, err
 	}
-	return &model.Movie{
-		ID:          strconv.FormatInt(movie.ID, 10),
-		Title:       movie.Title,
-		Description: movie.Description,
-		ReleaseDate: movie.ReleaseDate,
-	}, nil
+	return toMovieModel(movie), nil
 }
 
+// Node is the resolver for the node field.
+func (r *queryResolver) Node(ctx context.Context, id string) (model.Node, error) {
+	typeName, idInt, ok := fromGlobalID(id)
+	if !ok || typeName != movieTypeName {
+		return nil, invalidGlobalID("id")
+	}
+	movie, err := r.loadMovie(ctx, idInt)
+	if err != nil {
+		return nil, err
+	}
+	return toMovieModel(movie), nil
+}
+
+// Nodes is the resolver for the nodes field.
+func (r *queryResolver) Nodes(ctx context.Context, ids []string) ([]model.Node, error) {
+	movieIDs := make([]int64, len(ids))
+	for i, id := range ids {
+		typeName, idInt, ok := fromGlobalID(id)
+		if !ok || typeName != movieTypeName {
+			return nil, invalidGlobalID("ids." + strconv.Itoa(i))
+		}
+		movieIDs[i] = idInt
+	}
+	movies, err := r.Resolver.MovieUsecase.GetMoviesByIDs(ctx, movieIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	nodes := make([]model.Node, len(ids))
+	for i, id := range movieIDs {
+		movie, ok := movies[id]
+		if !ok {
+			// Report the missing object at its own index and keep the others.
+			graphql.AddError(graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i)), usecase.NewNotFoundError("movie %d not found", id))
+			continue
+		}
+		nodes[i] = toMovieModel(movie)
+	}
+	return nodes, nil
+}
+
+// Health is the resolver for the health field.
+func (r *queryResolver) Health(ctx context.Context) (*model.Health, error) {
+	return toHealthModel(r.Resolver.HealthUsecase.Readiness(ctx)), nil
+}
+
+// MovieChanged is the resolver for the movieChanged field.
+func (r *subscriptionResolver) MovieChanged(ctx context.Context, id *string) (<-chan *model.MovieChangedEvent, error) {
+	var movieID int64
+	if id != nil {
+		idInt, err := parseMovieID(*id)
+		if err != nil {
+			return nil, err
+		}
+		movieID = idInt
+	}
+	events, err := r.Resolver.MovieUsecase.SubscribeMovieChanges(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(chan *model.MovieChangedEvent)
+	go func() {
+		defer close(out)
+		for event := range events {
+			if id != nil && event.Movie.ID != movieID {
+				continue
+			}
+			select {
+			case out <- toMovieChangedEvent(event):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out, nil
+}
+
+// Mutation returns MutationResolver implementation.
+func (r *Resolver) Mutation() MutationResolver { return &mutationResolver{r} }
+
 // Query returns QueryResolver implementation.
 func (r *Resolver) Query() QueryResolver { return &queryResolver{r} }
 
+// Subscription returns SubscriptionResolver implementation.
+func (r *Resolver) Subscription() SubscriptionResolver { return &subscriptionResolver{r} }
+
+type mutationResolver struct{ *Resolver }
 type queryResolver struct{ *Resolver }
+type subscriptionResolver struct{ *Resolver }
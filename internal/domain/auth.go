@@ -0,0 +1,30 @@
+package domain
+
+import (
+	"slices"
+	"time"
+)
+
+// APIKey is a stored API key. Only the SHA-256 hash of the key is kept; the
+// prefix is the first few characters so operators can tell keys apart.
+// Scopes is space separated, like the OAuth 2.0 scope parameter.
+type APIKey struct {
+	ID        int64      `json:"id"`
+	Name      string     `json:"name"`
+	Prefix    string     `json:"prefix"`
+	KeyHash   string     `json:"-"`
+	Scopes    string     `json:"scopes"`
+	CreatedAt time.Time  `json:"created_at"`
+	RevokedAt *time.Time `json:"revoked_at,omitempty"`
+}
+
+// Principal is the authenticated caller of a request.
+type Principal struct {
+	Subject string
+	Name    string
+	Scopes  []string
+}
+
+func (p *Principal) HasScope(scope string) bool {
+	return slices.Contains(p.Scopes, scope)
+}
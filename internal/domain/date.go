@@ -0,0 +1,101 @@
+package domain
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"time"
+)
+
+// DateLayout is the ISO 8601 calendar date format every API uses for dates.
+const DateLayout = "2006-01-02"
+
+// Date is a calendar date with no time of day or time zone, stored in a
+// Postgres DATE column. The zero Date means no date and is stored as NULL.
+type Date struct {
+	Year  int
+	Month time.Month
+	Day   int
+}
+
+func ParseDate(s string) (Date, error) {
+	t, err := time.Parse(DateLayout, s)
+	if err != nil {
+		return Date{}, fmt.Errorf("%q is not a valid date in YYYY-MM-DD format", s)
+	}
+	return DateOf(t), nil
+}
+
+// DateOf returns the date of t in t's location.
+func DateOf(t time.Time) Date {
+	year, month, day := t.Date()
+	return Date{Year: year, Month: month, Day: day}
+}
+
+func (d Date) IsZero() bool {
+	return d == Date{}
+}
+
+// IsValid reports whether d is a real day between years 1 and 9999, the
+// range DateLayout can represent. Dates built from other encodings, such as
+// google.type.Date, may not be.
+func (d Date) IsValid() bool {
+	return d.Year >= 1 && d.Year <= 9999 && DateOf(d.Time()) == d
+}
+
+// Time returns midnight UTC at the start of d.
+func (d Date) Time() time.Time {
+	return time.Date(d.Year, d.Month, d.Day, 0, 0, 0, 0, time.UTC)
+}
+
+func (d Date) String() string {
+	if d.IsZero() {
+		return ""
+	}
+	return fmt.Sprintf("%04d-%02d-%02d", d.Year, d.Month, d.Day)
+}
+
+func (d Date) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+func (d *Date) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*d = Date{}
+		return nil
+	}
+	parsed, err := ParseDate(string(text))
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
+
+func (d *Date) Scan(value any) error {
+	switch v := value.(type) {
+	case nil:
+		*d = Date{}
+	case time.Time:
+		*d = DateOf(v)
+	case string:
+		return d.UnmarshalText([]byte(v))
+	case []byte:
+		return d.UnmarshalText(v)
+	default:
+		return fmt.Errorf("cannot scan %T into a date", value)
+	}
+	return nil
+}
+
+func (d Date) Value() (driver.Value, error) {
+	if d.IsZero() {
+		return nil, nil
+	}
+	return d.String(), nil
+}
+
+// GormDataType names the column type, which GORM would otherwise infer from
+// the first field of the struct.
+func (Date) GormDataType() string {
+	return "date"
+}
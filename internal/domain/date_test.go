@@ -0,0 +1,52 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseDate(t *testing.T) {
+	d, err := ParseDate("2021-10-22")
+	require.NoError(t, err)
+	assert.Equal(t, Date{Year: 2021, Month: time.October, Day: 22}, d)
+	assert.Equal(t, "2021-10-22", d.String())
+
+	for _, s := range []string{"", "2021-02-30", "22/10/2021", "2021-10-22T00:00:00Z"} {
+		_, err := ParseDate(s)
+		assert.Error(t, err, s)
+	}
+}
+
+func TestDate_IsValid(t *testing.T) {
+	assert.True(t, Date{Year: 2024, Month: time.February, Day: 29}.IsValid())
+	assert.False(t, Date{Year: 2023, Month: time.February, Day: 29}.IsValid())
+	assert.False(t, Date{Year: 0, Month: time.May, Day: 4}.IsValid())
+	assert.False(t, Date{Year: 2021, Month: 10}.IsValid())
+}
+
+func TestDate_Scan(t *testing.T) {
+	var d Date
+	require.NoError(t, d.Scan(time.Date(2021, time.October, 22, 0, 0, 0, 0, time.UTC)))
+	assert.Equal(t, Date{Year: 2021, Month: time.October, Day: 22}, d)
+
+	require.NoError(t, d.Scan(nil))
+	assert.True(t, d.IsZero())
+
+	require.NoError(t, d.Scan([]byte("1999-03-31")))
+	assert.Equal(t, Date{Year: 1999, Month: time.March, Day: 31}, d)
+
+	assert.Error(t, d.Scan(42))
+}
+
+func TestDate_Value(t *testing.T) {
+	v, err := Date{Year: 2021, Month: time.October, Day: 22}.Value()
+	require.NoError(t, err)
+	assert.Equal(t, "2021-10-22", v)
+
+	v, err = Date{}.Value()
+	require.NoError(t, err)
+	assert.Nil(t, v)
+}
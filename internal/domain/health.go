@@ -0,0 +1,19 @@
+package domain
+
+type HealthStatus string
+
+const (
+	HealthStatusUp   HealthStatus = "UP"
+	HealthStatusDown HealthStatus = "DOWN"
+)
+
+type HealthCheck struct {
+	Name   string       `json:"name"`
+	Status HealthStatus `json:"status"`
+	Error  string       `json:"error,omitempty"`
+}
+
+type HealthReport struct {
+	Status HealthStatus  `json:"status"`
+	Checks []HealthCheck `json:"checks"`
+}
@@ -4,5 +4,40 @@ type Movie struct {
 	ID          int64  `json:"id"`
 	Title       string `json:"title"`
 	Description string `json:"description"`
-	ReleaseDate string `json:"release_date"`
+	ReleaseDate Date   `json:"release_date"`
+}
+
+type MovieEdge struct {
+	Movie  Movie
+	Cursor string
+}
+
+type MoviePage struct {
+	Edges      []MovieEdge
+	NextCursor string
+}
+
+type MovieSortField string
+
+const (
+	MovieSortByID          MovieSortField = "ID"
+	MovieSortByTitle       MovieSortField = "TITLE"
+	MovieSortByReleaseDate MovieSortField = "RELEASE_DATE"
+)
+
+// MovieSort orders a listing. Ties are broken by id in the same direction, so
+// the order is total and a page can resume after any movie. The zero
+// MovieSort orders by id ascending.
+type MovieSort struct {
+	Field      MovieSortField
+	Descending bool
+}
+
+// MovieFilter narrows a listing. Zero fields match every movie.
+type MovieFilter struct {
+	// TitleContains matches a case-insensitive substring of the title.
+	TitleContains string
+	// ReleasedFrom and ReleasedTo bound the release date, inclusive.
+	ReleasedFrom Date
+	ReleasedTo   Date
 }
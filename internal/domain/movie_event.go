@@ -0,0 +1,16 @@
+package domain
+
+type MovieEventType string
+
+const (
+	MovieCreated MovieEventType = "CREATED"
+	MovieUpdated MovieEventType = "UPDATED"
+	MovieDeleted MovieEventType = "DELETED"
+)
+
+// MovieEvent describes a change to the catalog. For deletions Movie is the
+// movie as it was before it was removed.
+type MovieEvent struct {
+	Type  MovieEventType
+	Movie Movie
+}
@@ -0,0 +1,7 @@
+package domain
+
+type Poster struct {
+	MovieID     int64
+	ContentType string
+	Data        []byte
+}
@@ -1,5 +1,11 @@
 package dto
 
+type MovieRequest struct {
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	ReleaseDate string `json:"release_date"`
+}
+
 type MovieResponse struct {
 	ID          int64  `json:"id"`
 	Title       string `json:"title"`
@@ -0,0 +1,31 @@
+package auth
+
+import (
+	"context"
+	"strings"
+
+	"github.com/sorrawichYooboon/go-protocol-api-style/internal/domain"
+	"github.com/sorrawichYooboon/go-protocol-api-style/internal/usecase"
+)
+
+// Authenticator resolves the credentials sent over HTTP and gRPC: a bearer
+// JWT when there is one, an API key otherwise.
+type Authenticator struct {
+	authUsecase usecase.AuthUsecase
+	jwtVerifier *JWTVerifier
+}
+
+func NewAuthenticator(authUsecase usecase.AuthUsecase, jwtVerifier *JWTVerifier) *Authenticator {
+	return &Authenticator{authUsecase: authUsecase, jwtVerifier: jwtVerifier}
+}
+
+func (a *Authenticator) authenticate(ctx context.Context, authorization, apiKey string) (*domain.Principal, error) {
+	scheme, credentials, _ := strings.Cut(authorization, " ")
+	if strings.EqualFold(scheme, "Bearer") {
+		return a.jwtVerifier.Verify(strings.TrimSpace(credentials))
+	}
+	if apiKey == "" {
+		apiKey = apiKeyFromAuthorization(authorization)
+	}
+	return a.authUsecase.AuthenticateAPIKey(ctx, apiKey)
+}
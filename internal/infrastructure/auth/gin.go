@@ -0,0 +1,45 @@
+package auth
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sorrawichYooboon/go-protocol-api-style/internal/usecase"
+)
+
+const (
+	APIKeyHeader        = "X-API-Key"
+	authorizationScheme = "ApiKey"
+)
+
+// APIKeyFromHeader accepts either `X-API-Key: <key>` or
+// `Authorization: ApiKey <key>`.
+func APIKeyFromHeader(h http.Header) string {
+	if key := h.Get(APIKeyHeader); key != "" {
+		return key
+	}
+	return apiKeyFromAuthorization(h.Get("Authorization"))
+}
+
+func apiKeyFromAuthorization(value string) string {
+	scheme, key, ok := strings.Cut(value, " ")
+	if !ok || !strings.EqualFold(scheme, authorizationScheme) {
+		return ""
+	}
+	return strings.TrimSpace(key)
+}
+
+// Middleware authenticates the request and stores the principal in its
+// context. Failures are reported through reject like ratelimit.Middleware.
+func Middleware(authenticator *Authenticator, reject func(*gin.Context, error)) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		principal, err := authenticator.authenticate(c.Request.Context(), c.GetHeader("Authorization"), c.GetHeader(APIKeyHeader))
+		if err != nil {
+			reject(c, err)
+			return
+		}
+		c.Request = c.Request.WithContext(usecase.WithPrincipal(c.Request.Context(), principal))
+		c.Next()
+	}
+}
@@ -0,0 +1,77 @@
+package auth
+
+import (
+	"context"
+	"strings"
+
+	"github.com/sorrawichYooboon/go-protocol-api-style/internal/domain"
+	"github.com/sorrawichYooboon/go-protocol-api-style/internal/usecase"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	apiKeyMetadata = "x-api-key"
+	// Health probes never need credentials, like /healthz and /readyz over HTTP.
+	healthServicePrefix = "/grpc.health.v1.Health/"
+)
+
+// APIKeyFromMetadata reads `x-api-key` or `authorization: ApiKey <key>`.
+func APIKeyFromMetadata(ctx context.Context) string {
+	if key := firstMetadata(ctx, apiKeyMetadata); key != "" {
+		return key
+	}
+	return apiKeyFromAuthorization(firstMetadata(ctx, "authorization"))
+}
+
+func firstMetadata(ctx context.Context, key string) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	if v := md.Get(key); len(v) > 0 {
+		return v[0]
+	}
+	return ""
+}
+
+func (a *Authenticator) authenticateIncoming(ctx context.Context) (*domain.Principal, error) {
+	return a.authenticate(ctx, firstMetadata(ctx, "authorization"), firstMetadata(ctx, apiKeyMetadata))
+}
+
+// UnaryInterceptor must run inside the error interceptor so authentication
+// failures become UNAUTHENTICATED.
+func UnaryInterceptor(authenticator *Authenticator) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if strings.HasPrefix(info.FullMethod, healthServicePrefix) {
+			return handler(ctx, req)
+		}
+		principal, err := authenticator.authenticateIncoming(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return handler(usecase.WithPrincipal(ctx, principal), req)
+	}
+}
+
+func StreamInterceptor(authenticator *Authenticator) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if strings.HasPrefix(info.FullMethod, healthServicePrefix) {
+			return handler(srv, ss)
+		}
+		principal, err := authenticator.authenticateIncoming(ss.Context())
+		if err != nil {
+			return err
+		}
+		return handler(srv, &serverStream{ServerStream: ss, ctx: usecase.WithPrincipal(ss.Context(), principal)})
+	}
+}
+
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
@@ -0,0 +1,118 @@
+package auth
+
+import (
+	"crypto/rsa"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/sorrawichYooboon/go-protocol-api-style/internal/domain"
+	"github.com/sorrawichYooboon/go-protocol-api-style/internal/usecase"
+)
+
+const jwtLeeway = 30 * time.Second
+
+type JWTConfig struct {
+	// HS256Secret enables HS256 tokens when set.
+	HS256Secret string
+	// JWKSFile enables RS256 tokens signed by one of its keys when set.
+	JWKSFile string
+	Issuer   string
+	Audience string
+}
+
+// JWTVerifier validates bearer tokens and turns their claims into a principal.
+type JWTVerifier struct {
+	secret  []byte
+	rsaKeys map[string]*rsa.PublicKey
+	parser  *jwt.Parser
+}
+
+type jwtClaims struct {
+	jwt.RegisteredClaims
+	Name  string `json:"name,omitempty"`
+	Scope string `json:"scope,omitempty"`
+}
+
+// NewJWTVerifier returns nil when neither HS256 nor RS256 is configured, in
+// which case bearer tokens are rejected.
+func NewJWTVerifier(cfg JWTConfig) (*JWTVerifier, error) {
+	v := &JWTVerifier{}
+	var methods []string
+	if cfg.HS256Secret != "" {
+		v.secret = []byte(cfg.HS256Secret)
+		methods = append(methods, jwt.SigningMethodHS256.Alg())
+	}
+	if cfg.JWKSFile != "" {
+		keys, err := LoadJWKS(cfg.JWKSFile)
+		if err != nil {
+			return nil, err
+		}
+		v.rsaKeys = keys
+		methods = append(methods, jwt.SigningMethodRS256.Alg())
+	}
+	if len(methods) == 0 {
+		return nil, nil
+	}
+
+	opts := []jwt.ParserOption{
+		jwt.WithValidMethods(methods),
+		jwt.WithExpirationRequired(),
+		jwt.WithLeeway(jwtLeeway),
+	}
+	if cfg.Issuer != "" {
+		opts = append(opts, jwt.WithIssuer(cfg.Issuer))
+	}
+	if cfg.Audience != "" {
+		opts = append(opts, jwt.WithAudience(cfg.Audience))
+	}
+	v.parser = jwt.NewParser(opts...)
+	return v, nil
+}
+
+func (v *JWTVerifier) Verify(token string) (*domain.Principal, error) {
+	if v == nil {
+		return nil, usecase.NewUnauthenticatedError("bearer tokens are not accepted")
+	}
+
+	var claims jwtClaims
+	if _, err := v.parser.ParseWithClaims(token, &claims, v.key); err != nil {
+		return nil, &usecase.Error{Code: usecase.ErrCodeUnauthenticated, Message: "invalid bearer token", Err: err}
+	}
+	if claims.Subject == "" {
+		return nil, usecase.NewUnauthenticatedError("invalid bearer token")
+	}
+
+	name := claims.Name
+	if name == "" {
+		name = claims.Subject
+	}
+	return &domain.Principal{
+		Subject: "jwt:" + claims.Subject,
+		Name:    name,
+		Scopes:  strings.Fields(claims.Scope),
+	}, nil
+}
+
+// key picks the verification key for the token's algorithm. The parser has
+// already rejected algorithms that are not configured.
+func (v *JWTVerifier) key(token *jwt.Token) (any, error) {
+	switch token.Method.Alg() {
+	case jwt.SigningMethodHS256.Alg():
+		return v.secret, nil
+	case jwt.SigningMethodRS256.Alg():
+		kid, _ := token.Header["kid"].(string)
+		if key, ok := v.rsaKeys[kid]; ok {
+			return key, nil
+		}
+		if kid == "" && len(v.rsaKeys) == 1 {
+			for _, key := range v.rsaKeys {
+				return key, nil
+			}
+		}
+		return nil, fmt.Errorf("unknown key id %q", kid)
+	}
+	return nil, errors.New("unsupported signing method")
+}
@@ -0,0 +1,112 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/sorrawichYooboon/go-protocol-api-style/internal/domain"
+	"github.com/sorrawichYooboon/go-protocol-api-style/internal/usecase"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeJWKS(t *testing.T, kid string, key *rsa.PublicKey) string {
+	t.Helper()
+	set := jwks{Keys: []jwk{{
+		Kty: "RSA",
+		Kid: kid,
+		Use: "sig",
+		Alg: "RS256",
+		N:   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+		E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+	}}}
+	data, err := json.Marshal(set)
+	require.NoError(t, err)
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	require.NoError(t, os.WriteFile(path, data, 0o600))
+	return path
+}
+
+func claims(exp time.Time) jwt.MapClaims {
+	return jwt.MapClaims{
+		"sub":   "user-1",
+		"iss":   "movies-test",
+		"exp":   exp.Unix(),
+		"scope": "movies:read movies:write",
+	}
+}
+
+func TestJWTVerifier_Verify(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	verifier, err := NewJWTVerifier(JWTConfig{
+		HS256Secret: "secret",
+		JWKSFile:    writeJWKS(t, "k1", &rsaKey.PublicKey),
+		Issuer:      "movies-test",
+	})
+	require.NoError(t, err)
+
+	sign := func(method jwt.SigningMethod, kid string, key any, c jwt.MapClaims) string {
+		token := jwt.NewWithClaims(method, c)
+		if kid != "" {
+			token.Header["kid"] = kid
+		}
+		s, err := token.SignedString(key)
+		require.NoError(t, err)
+		return s
+	}
+	future := time.Now().Add(time.Hour)
+
+	tests := []struct {
+		name      string
+		token     string
+		wantError bool
+	}{
+		{name: "HS256", token: sign(jwt.SigningMethodHS256, "", []byte("secret"), claims(future))},
+		{name: "RS256 with kid", token: sign(jwt.SigningMethodRS256, "k1", rsaKey, claims(future))},
+		{name: "RS256 without kid uses the only key", token: sign(jwt.SigningMethodRS256, "", rsaKey, claims(future))},
+		{name: "wrong HS256 secret", token: sign(jwt.SigningMethodHS256, "", []byte("other"), claims(future)), wantError: true},
+		{name: "RS256 signed by unknown key", token: sign(jwt.SigningMethodRS256, "k1", otherKey, claims(future)), wantError: true},
+		{name: "unknown kid", token: sign(jwt.SigningMethodRS256, "k2", rsaKey, claims(future)), wantError: true},
+		{name: "expired", token: sign(jwt.SigningMethodHS256, "", []byte("secret"), claims(time.Now().Add(-time.Hour))), wantError: true},
+		{name: "unconfigured algorithm", token: sign(jwt.SigningMethodHS512, "", []byte("secret"), claims(future)), wantError: true},
+		{name: "wrong issuer", token: sign(jwt.SigningMethodHS256, "", []byte("secret"), jwt.MapClaims{"sub": "user-1", "iss": "other", "exp": future.Unix()}), wantError: true},
+		{name: "missing expiry", token: sign(jwt.SigningMethodHS256, "", []byte("secret"), jwt.MapClaims{"sub": "user-1", "iss": "movies-test"}), wantError: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			principal, err := verifier.Verify(test.token)
+			if test.wantError {
+				assert.Nil(t, principal)
+				assert.Equal(t, usecase.ErrCodeUnauthenticated, usecase.AsError(err).Code)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, &domain.Principal{
+				Subject: "jwt:user-1",
+				Name:    "user-1",
+				Scopes:  []string{usecase.ScopeMoviesRead, usecase.ScopeMoviesWrite},
+			}, principal)
+		})
+	}
+}
+
+func TestJWTVerifier_NotConfigured(t *testing.T) {
+	verifier, err := NewJWTVerifier(JWTConfig{})
+	require.NoError(t, err)
+
+	_, err = verifier.Verify("anything")
+	assert.Equal(t, usecase.NewUnauthenticatedError("bearer tokens are not accepted"), err)
+}
@@ -0,0 +1,27 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/sorrawichYooboon/go-protocol-api-style/internal/usecase"
+)
+
+// WebsocketInit authenticates a GraphQL WebSocket connection from its
+// connection_init payload, since browsers cannot set headers on the upgrade
+// request. The payload carries the same credentials as the HTTP headers:
+// `{"Authorization": "Bearer <jwt>"}` or `{"X-API-Key": "<key>"}`. A rejected
+// connection is closed before any operation runs.
+func WebsocketInit(authenticator *Authenticator) transport.WebsocketInitFunc {
+	return func(ctx context.Context, payload transport.InitPayload) (context.Context, *transport.InitPayload, error) {
+		apiKey := payload.GetString(APIKeyHeader)
+		if apiKey == "" {
+			apiKey = payload.GetString(apiKeyMetadata)
+		}
+		principal, err := authenticator.authenticate(ctx, payload.Authorization(), apiKey)
+		if err != nil {
+			return ctx, nil, err
+		}
+		return usecase.WithPrincipal(ctx, principal), nil, nil
+	}
+}
@@ -0,0 +1,80 @@
+package blob
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/sorrawichYooboon/go-protocol-api-style/internal/domain"
+	"github.com/sorrawichYooboon/go-protocol-api-style/internal/repository"
+)
+
+// FilesystemPosterRepository keeps each poster in a file named after the
+// movie id. No metadata is stored; the content type is sniffed on read.
+type FilesystemPosterRepository struct {
+	dir string
+}
+
+func NewFilesystemPosterRepository(dir string) (repository.PosterRepository, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("create poster directory: %w", err)
+	}
+	return &FilesystemPosterRepository{dir: dir}, nil
+}
+
+// Put writes to a temporary file first so readers never see a partial poster.
+func (r *FilesystemPosterRepository) Put(ctx context.Context, poster *domain.Poster) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(r.dir, ".upload-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(poster.Data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp.Name(), r.path(poster.MovieID)); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *FilesystemPosterRepository) Get(ctx context.Context, movieID int64) (*domain.Poster, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(r.path(movieID))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &domain.Poster{MovieID: movieID, ContentType: http.DetectContentType(data), Data: data}, nil
+}
+
+func (r *FilesystemPosterRepository) Delete(ctx context.Context, movieID int64) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if err := os.Remove(r.path(movieID)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+func (r *FilesystemPosterRepository) path(movieID int64) string {
+	return filepath.Join(r.dir, strconv.FormatInt(movieID, 10))
+}
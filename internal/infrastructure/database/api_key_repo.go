@@ -0,0 +1,55 @@
+package database
+
+import (
+	"context"
+
+	"github.com/sorrawichYooboon/go-protocol-api-style/internal/domain"
+	"github.com/sorrawichYooboon/go-protocol-api-style/internal/repository"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type APIKeyRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewAPIKeyRepository(db *gorm.DB) repository.APIKeyRepository {
+	return &APIKeyRepositoryImpl{db: db}
+}
+
+func (r *APIKeyRepositoryImpl) Create(ctx context.Context, key *domain.APIKey) (*domain.APIKey, error) {
+	created := *key
+	created.ID = 0
+	if err := r.db.WithContext(ctx).Table("api_keys").Create(&created).Error; err != nil {
+		return nil, translateError(err)
+	}
+	return &created, nil
+}
+
+func (r *APIKeyRepositoryImpl) GetByHash(ctx context.Context, keyHash string) (*domain.APIKey, error) {
+	var key domain.APIKey
+	if err := r.db.WithContext(ctx).Table("api_keys").Where("key_hash = ?", keyHash).First(&key).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, translateError(err)
+	}
+	return &key, nil
+}
+
+// Revoke returns nil when the key does not exist or was already revoked.
+func (r *APIKeyRepositoryImpl) Revoke(ctx context.Context, id int64) (*domain.APIKey, error) {
+	var revoked domain.APIKey
+	result := r.db.WithContext(ctx).Table("api_keys").
+		Model(&revoked).
+		Clauses(clause.Returning{}).
+		Where("id = ? AND revoked_at IS NULL", id).
+		Update("revoked_at", gorm.Expr("now()"))
+	if result.Error != nil {
+		return nil, translateError(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return nil, nil
+	}
+	return &revoked, nil
+}
@@ -0,0 +1,44 @@
+package database
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"net"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/sorrawichYooboon/go-protocol-api-style/internal/repository"
+)
+
+const (
+	pgUniqueViolation   = "23505"
+	pgClassConnection   = "08"
+	pgClassInsufficient = "53"
+	pgClassOperator     = "57"
+)
+
+// translateError maps driver errors onto the repository sentinels so the
+// usecase layer can classify failures without knowing about Postgres.
+func translateError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch {
+		case pgErr.Code == pgUniqueViolation:
+			return fmt.Errorf("%w: %v", repository.ErrConflict, err)
+		case len(pgErr.Code) >= 2 && (pgErr.Code[:2] == pgClassConnection || pgErr.Code[:2] == pgClassInsufficient || pgErr.Code[:2] == pgClassOperator):
+			return fmt.Errorf("%w: %v", repository.ErrUnavailable, err)
+		}
+		return err
+	}
+
+	var connectErr *pgconn.ConnectError
+	var netErr net.Error
+	if errors.As(err, &connectErr) || errors.As(err, &netErr) || errors.Is(err, driver.ErrBadConn) || pgconn.Timeout(err) {
+		return fmt.Errorf("%w: %v", repository.ErrUnavailable, err)
+	}
+	return err
+}
@@ -0,0 +1,37 @@
+package database
+
+import (
+	"context"
+
+	"github.com/sorrawichYooboon/go-protocol-api-style/internal/repository"
+	"gorm.io/gorm"
+)
+
+type HealthRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewHealthRepository(db *gorm.DB) repository.HealthRepository {
+	return &HealthRepositoryImpl{db: db}
+}
+
+func (r *HealthRepositoryImpl) Ping(ctx context.Context) error {
+	sqlDB, err := r.db.DB()
+	if err != nil {
+		return err
+	}
+	return translateError(sqlDB.PingContext(ctx))
+}
+
+// MigrationVersion reads the state golang-migrate keeps in schema_migrations.
+func (r *HealthRepositoryImpl) MigrationVersion(ctx context.Context) (uint, bool, error) {
+	var state struct {
+		Version uint
+		Dirty   bool
+	}
+	result := r.db.WithContext(ctx).Table("schema_migrations").Select("version", "dirty").Limit(1).Find(&state)
+	if result.Error != nil {
+		return 0, false, translateError(result.Error)
+	}
+	return state.Version, state.Dirty, nil
+}
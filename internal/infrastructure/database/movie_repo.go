@@ -1,9 +1,15 @@
 package database
 
 import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
 	"github.com/sorrawichYooboon/go-protocol-api-style/internal/domain"
 	"github.com/sorrawichYooboon/go-protocol-api-style/internal/repository"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type MovieRepositoryImpl struct {
@@ -14,21 +20,191 @@ func NewMovieRepository(db *gorm.DB) repository.MovieRepository {
 	return &MovieRepositoryImpl{db: db}
 }
 
-func (r *MovieRepositoryImpl) GetAll() ([]domain.Movie, error) {
+func (r *MovieRepositoryImpl) GetAll(ctx context.Context) ([]domain.Movie, error) {
 	var movies []domain.Movie
-	if err := r.db.Table("movies").Find(&movies).Error; err != nil {
-		return nil, err
+	if err := r.db.WithContext(ctx).Table("movies").Find(&movies).Error; err != nil {
+		return nil, translateError(err)
 	}
 	return movies, nil
 }
 
-func (r *MovieRepositoryImpl) GetByID(id int64) (*domain.Movie, error) {
+// movieSortColumns whitelists the columns a listing may be ordered by, so
+// sort fields never reach the SQL as text.
+var movieSortColumns = map[domain.MovieSortField]string{
+	domain.MovieSortByID:          "id",
+	domain.MovieSortByTitle:       "title",
+	domain.MovieSortByReleaseDate: "release_date",
+}
+
+// List pages with a keyset on (sort column, id). Movies without a release
+// date sort last in both directions.
+func (r *MovieRepositoryImpl) List(ctx context.Context, filter domain.MovieFilter, sort domain.MovieSort, limit int, after *domain.Movie) ([]domain.Movie, error) {
+	column, ok := movieSortColumns[sort.Field]
+	if sort.Field == "" {
+		column, ok = "id", true
+	}
+	if !ok {
+		return nil, fmt.Errorf("unsupported movie sort field %q", sort.Field)
+	}
+	cmp, dir := ">", "ASC"
+	if sort.Descending {
+		cmp, dir = "<", "DESC"
+	}
+
+	query := r.db.WithContext(ctx).Table("movies")
+	if filter.TitleContains != "" {
+		query = query.Where("title ILIKE ?", "%"+likeEscaper.Replace(filter.TitleContains)+"%")
+	}
+	if !filter.ReleasedFrom.IsZero() {
+		query = query.Where("release_date >= ?", filter.ReleasedFrom)
+	}
+	if !filter.ReleasedTo.IsZero() {
+		query = query.Where("release_date <= ?", filter.ReleasedTo)
+	}
+
+	if after != nil {
+		switch column {
+		case "id":
+			query = query.Where("id "+cmp+" ?", after.ID)
+		case "title":
+			query = query.Where("(title, id) "+cmp+" (?, ?)", after.Title, after.ID)
+		case "release_date":
+			if after.ReleaseDate.IsZero() {
+				query = query.Where("release_date IS NULL AND id "+cmp+" ?", after.ID)
+			} else {
+				query = query.Where("((release_date, id) "+cmp+" (?, ?) OR release_date IS NULL)", after.ReleaseDate, after.ID)
+			}
+		}
+	}
+
+	order := column + " " + dir
+	if column == "release_date" {
+		order += " NULLS LAST"
+	}
+	if column != "id" {
+		order += ", id " + dir
+	}
+
+	var movies []domain.Movie
+	if err := query.Order(order).Limit(limit).Find(&movies).Error; err != nil {
+		return nil, translateError(err)
+	}
+	return movies, nil
+}
+
+// Search matches query as a case-insensitive substring of the title or
+// description.
+func (r *MovieRepositoryImpl) Search(ctx context.Context, query string, limit int, afterID int64) ([]domain.Movie, error) {
+	var movies []domain.Movie
+	pattern := "%" + likeEscaper.Replace(query) + "%"
+	if err := r.db.WithContext(ctx).Table("movies").
+		Where("id > ?", afterID).
+		Where("(title ILIKE ? OR description ILIKE ?)", pattern, pattern).
+		Order("id ASC").
+		Limit(limit).
+		Find(&movies).Error; err != nil {
+		return nil, translateError(err)
+	}
+	return movies, nil
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
+func (r *MovieRepositoryImpl) Iterate(ctx context.Context) (repository.MovieIterator, error) {
+	rows, err := r.db.WithContext(ctx).Table("movies").Order("id ASC").Rows()
+	if err != nil {
+		return nil, translateError(err)
+	}
+	return &movieRowsIterator{db: r.db, rows: rows}, nil
+}
+
+func (r *MovieRepositoryImpl) GetByID(ctx context.Context, id int64) (*domain.Movie, error) {
 	var movie domain.Movie
-	if err := r.db.Table("movies").Where("id = ?", id).First(&movie).Error; err != nil {
+	if err := r.db.WithContext(ctx).Table("movies").Where("id = ?", id).First(&movie).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
 			return nil, nil
 		}
-		return nil, err
+		return nil, translateError(err)
 	}
 	return &movie, nil
 }
+
+func (r *MovieRepositoryImpl) GetByIDs(ctx context.Context, ids []int64) ([]domain.Movie, error) {
+	var movies []domain.Movie
+	if len(ids) == 0 {
+		return movies, nil
+	}
+	if err := r.db.WithContext(ctx).Table("movies").Where("id IN ?", ids).Find(&movies).Error; err != nil {
+		return nil, translateError(err)
+	}
+	return movies, nil
+}
+
+func (r *MovieRepositoryImpl) Create(ctx context.Context, movie *domain.Movie) (*domain.Movie, error) {
+	created := *movie
+	created.ID = 0
+	if err := r.db.WithContext(ctx).Table("movies").Create(&created).Error; err != nil {
+		return nil, translateError(err)
+	}
+	return &created, nil
+}
+
+func (r *MovieRepositoryImpl) Update(ctx context.Context, movie *domain.Movie) (*domain.Movie, error) {
+	var updated domain.Movie
+	result := r.db.WithContext(ctx).Table("movies").
+		Model(&updated).
+		Clauses(clause.Returning{}).
+		Where("id = ?", movie.ID).
+		Updates(map[string]any{
+			"title":        movie.Title,
+			"description":  movie.Description,
+			"release_date": movie.ReleaseDate,
+		})
+	if result.Error != nil {
+		return nil, translateError(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return nil, nil
+	}
+	return &updated, nil
+}
+
+func (r *MovieRepositoryImpl) Delete(ctx context.Context, id int64) (*domain.Movie, error) {
+	var deleted domain.Movie
+	result := r.db.WithContext(ctx).Table("movies").
+		Clauses(clause.Returning{}).
+		Where("id = ?", id).
+		Delete(&deleted)
+	if result.Error != nil {
+		return nil, translateError(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return nil, nil
+	}
+	return &deleted, nil
+}
+
+type movieRowsIterator struct {
+	db   *gorm.DB
+	rows *sql.Rows
+}
+
+func (it *movieRowsIterator) Next() bool {
+	return it.rows.Next()
+}
+
+func (it *movieRowsIterator) Movie() (*domain.Movie, error) {
+	var movie domain.Movie
+	if err := it.db.ScanRows(it.rows, &movie); err != nil {
+		return nil, translateError(err)
+	}
+	return &movie, nil
+}
+
+func (it *movieRowsIterator) Err() error {
+	return translateError(it.rows.Err())
+}
+
+func (it *movieRowsIterator) Close() error {
+	return it.rows.Close()
+}
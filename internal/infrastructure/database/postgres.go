@@ -27,3 +27,11 @@ func Connect(cfg *config.Config) *gorm.DB {
 
 	return db
 }
+
+func Close(db *gorm.DB) error {
+	sqlDB, err := db.DB()
+	if err != nil {
+		return err
+	}
+	return sqlDB.Close()
+}
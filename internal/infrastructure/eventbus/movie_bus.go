@@ -0,0 +1,53 @@
+package eventbus
+
+import (
+	"context"
+	"sync"
+
+	"github.com/sorrawichYooboon/go-protocol-api-style/internal/domain"
+	"github.com/sorrawichYooboon/go-protocol-api-style/internal/repository"
+	"github.com/sorrawichYooboon/go-protocol-api-style/logger"
+)
+
+// subscriberBuffer is how many events a subscriber may fall behind before
+// further events are dropped for it.
+const subscriberBuffer = 64
+
+type movieBus struct {
+	mu          sync.Mutex
+	subscribers map[chan domain.MovieEvent]struct{}
+}
+
+// NewMovieBus returns an in-process MovieEventBus. Events only reach
+// subscribers in the same process.
+func NewMovieBus() repository.MovieEventBus {
+	return &movieBus{subscribers: make(map[chan domain.MovieEvent]struct{})}
+}
+
+func (b *movieBus) Publish(event domain.MovieEvent) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for ch := range b.subscribers {
+		select {
+		case ch <- event:
+		default:
+			logger.Log("WARN", "eventbus.Publish", "dropped movie event for slow subscriber", map[string]any{"movie_id": event.Movie.ID, "type": string(event.Type)})
+		}
+	}
+}
+
+func (b *movieBus) Subscribe(ctx context.Context) <-chan domain.MovieEvent {
+	ch := make(chan domain.MovieEvent, subscriberBuffer)
+	b.mu.Lock()
+	b.subscribers[ch] = struct{}{}
+	b.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+		b.mu.Lock()
+		delete(b.subscribers, ch)
+		close(ch)
+		b.mu.Unlock()
+	}()
+	return ch
+}
@@ -0,0 +1,42 @@
+package eventbus
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sorrawichYooboon/go-protocol-api-style/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMovieBus(t *testing.T) {
+	bus := NewMovieBus()
+	ctx, cancel := context.WithCancel(context.Background())
+	events := bus.Subscribe(ctx)
+
+	event := domain.MovieEvent{Type: domain.MovieCreated, Movie: domain.Movie{ID: 1}}
+	bus.Publish(event)
+	assert.Equal(t, event, <-events)
+
+	cancel()
+	select {
+	case _, ok := <-events:
+		assert.False(t, ok)
+	case <-time.After(time.Second):
+		t.Fatal("subscription was not closed")
+	}
+	bus.Publish(event)
+}
+
+func TestMovieBus_slowSubscriber(t *testing.T) {
+	bus := NewMovieBus()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	events := bus.Subscribe(ctx)
+
+	for i := range subscriberBuffer + 1 {
+		bus.Publish(domain.MovieEvent{Type: domain.MovieUpdated, Movie: domain.Movie{ID: int64(i)}})
+	}
+	assert.Len(t, events, subscriberBuffer)
+	assert.Equal(t, int64(0), (<-events).Movie.ID)
+}
@@ -0,0 +1,58 @@
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/sorrawichYooboon/go-protocol-api-style/internal/usecase"
+)
+
+// costSchema prices fields by their @cost directive in the schema, so the
+// complexity limit uses the weights declared next to each field instead of
+// per-field Go functions.
+type costSchema struct {
+	graphql.ExecutableSchema
+}
+
+func (s costSchema) Complexity(ctx context.Context, typeName, field string, childComplexity int, args map[string]any) (int, bool) {
+	def := s.Schema().Types[typeName]
+	if def == nil || def.Fields.ForName(field) == nil {
+		return s.ExecutableSchema.Complexity(ctx, typeName, field, childComplexity, args)
+	}
+	cost := def.Fields.ForName(field).Directives.ForName("cost")
+	if cost == nil {
+		return s.ExecutableSchema.Complexity(ctx, typeName, field, childComplexity, args)
+	}
+
+	weight, _ := strconv.Atoi(cost.Arguments.ForName("weight").Value.Raw)
+	if arg := cost.Arguments.ForName("pageSizeArg"); arg != nil {
+		childComplexity *= pageSize(args[arg.Value.Raw])
+	}
+	return weight + childComplexity, true
+}
+
+// pageSize mirrors the usecase's page size normalisation for a raw argument
+// value, which is an int64 for literals and a json.Number for variables. A
+// list argument counts as a page of its length.
+func pageSize(v any) int {
+	var n int64
+	switch v := v.(type) {
+	case int64:
+		n = v
+	case int:
+		n = int64(v)
+	case json.Number:
+		n, _ = v.Int64()
+	case []any:
+		n = int64(len(v))
+	}
+	switch {
+	case n <= 0:
+		return usecase.DefaultPageSize
+	case n > usecase.MaxPageSize:
+		return usecase.MaxPageSize
+	}
+	return int(n)
+}
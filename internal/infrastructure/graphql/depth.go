@@ -0,0 +1,65 @@
+package graphql
+
+import (
+	"context"
+	"strings"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/errcode"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+const errDepthLimit = "DEPTH_LIMIT_EXCEEDED"
+
+// depthLimit rejects operations whose fields nest deeper than limit, like
+// extension.ComplexityLimit does for cost. Introspection fields are not
+// counted; they are governed by the introspection switch instead.
+type depthLimit struct {
+	limit int
+}
+
+var _ interface {
+	graphql.OperationContextMutator
+	graphql.HandlerExtension
+} = depthLimit{}
+
+func (depthLimit) ExtensionName() string {
+	return "DepthLimit"
+}
+
+func (depthLimit) Validate(graphql.ExecutableSchema) error {
+	return nil
+}
+
+func (d depthLimit) MutateOperationContext(ctx context.Context, opCtx *graphql.OperationContext) *gqlerror.Error {
+	op := opCtx.Doc.Operations.ForName(opCtx.OperationName)
+	if depth := selectionDepth(op.SelectionSet); depth > d.limit {
+		err := gqlerror.Errorf("operation has depth %d, which exceeds the limit of %d", depth, d.limit)
+		errcode.Set(err, errDepthLimit)
+		return err
+	}
+	return nil
+}
+
+// selectionDepth counts nested fields. Fragments are inlined; validation has
+// already rejected fragment cycles.
+func selectionDepth(set ast.SelectionSet) int {
+	depth := 0
+	for _, selection := range set {
+		var d int
+		switch s := selection.(type) {
+		case *ast.Field:
+			if strings.HasPrefix(s.Name, "__") {
+				continue
+			}
+			d = 1 + selectionDepth(s.SelectionSet)
+		case *ast.FragmentSpread:
+			d = selectionDepth(s.Definition.SelectionSet)
+		case *ast.InlineFragment:
+			d = selectionDepth(s.SelectionSet)
+		}
+		depth = max(depth, d)
+	}
+	return depth
+}
@@ -0,0 +1,44 @@
+package graphql
+
+import (
+	"context"
+	"errors"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/sorrawichYooboon/go-protocol-api-style/internal/usecase"
+	"github.com/sorrawichYooboon/go-protocol-api-style/logger"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// errorPresenter adds a machine readable `code` extension to resolver errors
+// and hides the message of anything that is not a typed usecase error.
+func errorPresenter(ctx context.Context, err error) *gqlerror.Error {
+	gqlErr := graphql.DefaultErrorPresenter(ctx, err)
+
+	var ucErr *usecase.Error
+	if !errors.As(err, &ucErr) {
+		var ownErr *gqlerror.Error
+		if errors.As(err, &ownErr) {
+			// Parse, validation and coercion errors produced by gqlgen itself.
+			return gqlErr
+		}
+		if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+			gqlErr.Extensions = map[string]any{"code": "CANCELLED"}
+			return gqlErr
+		}
+		ucErr = usecase.AsError(err)
+		logger.LogError("graphql.errorPresenter", err)
+	}
+
+	gqlErr.Message = ucErr.Message
+	extensions := map[string]any{"code": string(ucErr.Code)}
+	if len(ucErr.Violations) > 0 {
+		violations := make([]map[string]string, 0, len(ucErr.Violations))
+		for _, v := range ucErr.Violations {
+			violations = append(violations, map[string]string{"field": v.Field, "description": v.Description})
+		}
+		extensions["violations"] = violations
+	}
+	gqlErr.Extensions = extensions
+	return gqlErr
+}
@@ -0,0 +1,90 @@
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/99designs/gqlgen/complexity"
+	"github.com/sorrawichYooboon/go-protocol-api-style/graph"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+func parseOperation(t *testing.T, query string) (*ast.OperationDefinition, costSchema) {
+	t.Helper()
+	es := costSchema{graph.NewExecutableSchema(graph.Config{})}
+	doc, errs := gqlparser.LoadQuery(es.Schema(), query)
+	require.Empty(t, errs)
+	return doc.Operations[0], es
+}
+
+func TestCostSchema(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+		vars  map[string]any
+		want  int
+	}{
+		{name: "unannotated fields cost 1", query: `{ movie(id: "1") { id title } }`, want: 5 + 2},
+		{name: "page size from literal", query: `{ moviesConnection(first: 10) { edges { node { id } } } }`, want: 5 + 10*(1+1+1)},
+		{name: "page size from variable", query: `query($n: Int) { moviesConnection(first: $n) { edges { node { id } } } }`, vars: map[string]any{"n": json.Number("3")}, want: 5 + 3*3},
+		{name: "default page size", query: `{ moviesConnection { edges { node { id } } } }`, want: 5 + 20*3},
+		{name: "page size clamped to maximum", query: `{ moviesConnection(first: 1000) { edges { node { id } } } }`, want: 5 + 100*3},
+		{name: "mutation weight", query: `mutation { deleteMovie(id: "1") { id } }`, want: 10 + 1},
+		{name: "page size from list length", query: `{ nodes(ids: ["a", "b", "c"]) { id } }`, want: 5 + 3*1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			op, es := parseOperation(t, tt.query)
+			assert.Equal(t, tt.want, complexity.Calculate(context.Background(), es, op, tt.vars))
+		})
+	}
+}
+
+func TestSelectionDepth(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+		want  int
+	}{
+		{name: "nested fields", query: `{ moviesConnection { edges { node { id } } pageInfo { hasNextPage } } }`, want: 4},
+		{name: "fragments are inlined", query: `fragment N on MovieEdge { node { id } } { moviesConnection { edges { ...N } } }`, want: 4},
+		{name: "introspection is not counted", query: `{ health { status } __schema { types { fields { type { ofType { name } } } } } }`, want: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			op, _ := parseOperation(t, tt.query)
+			assert.Equal(t, tt.want, selectionDepth(op.SelectionSet))
+		})
+	}
+}
+
+func TestRootFieldAllowlist(t *testing.T) {
+	allowlist := rootFieldAllowlist{fields: []string{"health"}}
+	tests := []struct {
+		name    string
+		query   string
+		want    string
+		blocked bool
+	}{
+		{name: "allowed field", query: `{ health { status } __typename }`},
+		{name: "other field", query: `{ health { status } movie(id: "1") { id } }`, want: "movie", blocked: true},
+		{name: "field inside a fragment", query: `fragment F on Query { movies { id } } { ...F }`, want: "movies", blocked: true},
+		{name: "field inside an inline fragment", query: `{ ... on Query { nodes(ids: []) { id } } }`, want: "nodes", blocked: true},
+		{name: "mutation", query: `mutation { deleteMovie(id: "1") { id } }`, want: "deleteMovie", blocked: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			op, _ := parseOperation(t, tt.query)
+			got, blocked := allowlist.firstDisallowed(op.SelectionSet)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.blocked, blocked)
+		})
+	}
+}
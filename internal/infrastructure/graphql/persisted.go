@@ -0,0 +1,70 @@
+package graphql
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/errcode"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+const errPersistedQueryNotAllowed = "PERSISTED_QUERY_NOT_ALLOWED"
+
+// persistedQueryAllowlist only runs the queries in manifest. Clients send
+// the hash in extensions.persistedQuery, as they would for APQ, or the full
+// text of a listed query. The manifest is checked against the schema when
+// the extension is added, so a stale entry fails at startup.
+type persistedQueryAllowlist struct {
+	manifest []byte
+	queries  map[string]string
+}
+
+var _ interface {
+	graphql.OperationParameterMutator
+	graphql.HandlerExtension
+} = &persistedQueryAllowlist{}
+
+func (*persistedQueryAllowlist) ExtensionName() string {
+	return "PersistedQueryAllowlist"
+}
+
+func (p *persistedQueryAllowlist) Validate(schema graphql.ExecutableSchema) error {
+	if err := json.Unmarshal(p.manifest, &p.queries); err != nil {
+		return fmt.Errorf("invalid persisted query manifest: %w", err)
+	}
+	for hash, query := range p.queries {
+		if queryHash(query) != hash {
+			return fmt.Errorf("persisted query %s does not match its hash", hash)
+		}
+		if _, errs := gqlparser.LoadQuery(schema.Schema(), query); len(errs) > 0 {
+			return fmt.Errorf("persisted query %s: %w", hash, errs)
+		}
+	}
+	return nil
+}
+
+func (p *persistedQueryAllowlist) MutateOperationParameters(ctx context.Context, rawParams *graphql.RawParams) *gqlerror.Error {
+	hash := queryHash(rawParams.Query)
+	if rawParams.Query == "" {
+		extension, _ := rawParams.Extensions["persistedQuery"].(map[string]any)
+		hash, _ = extension["sha256Hash"].(string)
+	}
+	query, ok := p.queries[hash]
+	if !ok {
+		err := gqlerror.Errorf("only persisted queries are accepted and this query is not in the allowlist")
+		errcode.Set(err, errPersistedQueryNotAllowed)
+		return err
+	}
+	rawParams.Query = query
+	return nil
+}
+
+func queryHash(query string) string {
+	sum := sha256.Sum256([]byte(query))
+	return hex.EncodeToString(sum[:])
+}
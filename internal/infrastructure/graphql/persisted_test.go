@@ -0,0 +1,75 @@
+package graphql
+
+import (
+	"context"
+	"testing"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/sorrawichYooboon/go-protocol-api-style/graph"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const listedQuery = `mutation DeleteMovie($id: ID!) { deleteMovie(id: $id) { id } }`
+
+func TestPersistedQueryAllowlist_manifest(t *testing.T) {
+	allowlist := &persistedQueryAllowlist{manifest: graph.PersistedQueries}
+	require.NoError(t, allowlist.Validate(graph.NewExecutableSchema(graph.Config{})))
+	assert.Equal(t, listedQuery, allowlist.queries[queryHash(listedQuery)])
+}
+
+func TestPersistedQueryAllowlist_invalidManifest(t *testing.T) {
+	es := graph.NewExecutableSchema(graph.Config{})
+	tests := []struct {
+		name     string
+		manifest string
+	}{
+		{name: "not json", manifest: `[`},
+		{name: "hash mismatch", manifest: `{"abc": "{ health { status } }"}`},
+		{name: "not in schema", manifest: `{"` + queryHash(`{ actors { id } }`) + `": "{ actors { id } }"}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			allowlist := &persistedQueryAllowlist{manifest: []byte(tt.manifest)}
+			assert.Error(t, allowlist.Validate(es))
+		})
+	}
+}
+
+func TestPersistedQueryAllowlist_MutateOperationParameters(t *testing.T) {
+	allowlist := &persistedQueryAllowlist{queries: map[string]string{queryHash(listedQuery): listedQuery}}
+	tests := []struct {
+		name      string
+		params    graphql.RawParams
+		wantQuery string
+		wantErr   bool
+	}{
+		{
+			name:      "listed hash",
+			params:    graphql.RawParams{Extensions: map[string]any{"persistedQuery": map[string]any{"version": 1, "sha256Hash": queryHash(listedQuery)}}},
+			wantQuery: listedQuery,
+		},
+		{name: "listed query text", params: graphql.RawParams{Query: listedQuery}, wantQuery: listedQuery},
+		{
+			name:    "unknown hash",
+			params:  graphql.RawParams{Extensions: map[string]any{"persistedQuery": map[string]any{"version": 1, "sha256Hash": "abc"}}},
+			wantErr: true,
+		},
+		{name: "ad-hoc query", params: graphql.RawParams{Query: `{ movies { id } }`}, wantErr: true},
+		{name: "no query", params: graphql.RawParams{}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := allowlist.MutateOperationParameters(context.Background(), &tt.params)
+			if tt.wantErr {
+				require.NotNil(t, err)
+				assert.Equal(t, errPersistedQueryNotAllowed, err.Extensions["code"])
+				return
+			}
+			require.Nil(t, err)
+			assert.Equal(t, tt.wantQuery, tt.params.Query)
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package graphql
+
+import (
+	"context"
+	"slices"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/errcode"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+const errFieldNotAllowed = "FIELD_NOT_ALLOWED"
+
+// rootFieldAllowlist rejects operations that select a root field outside
+// fields, so an endpoint can expose part of the schema, e.g. health without
+// credentials. __typename is always allowed.
+type rootFieldAllowlist struct {
+	fields []string
+}
+
+var _ interface {
+	graphql.OperationContextMutator
+	graphql.HandlerExtension
+} = rootFieldAllowlist{}
+
+func (rootFieldAllowlist) ExtensionName() string {
+	return "RootFieldAllowlist"
+}
+
+func (rootFieldAllowlist) Validate(graphql.ExecutableSchema) error {
+	return nil
+}
+
+func (r rootFieldAllowlist) MutateOperationContext(ctx context.Context, opCtx *graphql.OperationContext) *gqlerror.Error {
+	op := opCtx.Doc.Operations.ForName(opCtx.OperationName)
+	if name, ok := r.firstDisallowed(op.SelectionSet); ok {
+		err := gqlerror.Errorf("%s is not available on this endpoint", name)
+		errcode.Set(err, errFieldNotAllowed)
+		return err
+	}
+	return nil
+}
+
+// firstDisallowed returns the first root field not in the allowlist.
+// Fragments are inlined, as in selectionDepth.
+func (r rootFieldAllowlist) firstDisallowed(set ast.SelectionSet) (string, bool) {
+	for _, selection := range set {
+		switch s := selection.(type) {
+		case *ast.Field:
+			if s.Name != "__typename" && !slices.Contains(r.fields, s.Name) {
+				return s.Name, true
+			}
+		case *ast.FragmentSpread:
+			if name, ok := r.firstDisallowed(s.Definition.SelectionSet); ok {
+				return name, true
+			}
+		case *ast.InlineFragment:
+			if name, ok := r.firstDisallowed(s.SelectionSet); ok {
+				return name, true
+			}
+		}
+	}
+	return "", false
+}
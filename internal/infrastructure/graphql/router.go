@@ -1,25 +1,110 @@
 package graphql
 
 import (
+	"context"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
 	"github.com/99designs/gqlgen/graphql/handler"
 	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/lru"
 	"github.com/99designs/gqlgen/graphql/handler/transport"
 	"github.com/99designs/gqlgen/graphql/playground"
 	"github.com/gin-gonic/gin"
 	"github.com/sorrawichYooboon/go-protocol-api-style/graph"
 )
 
-func GraphqlHandler(resolver *graph.Resolver) gin.HandlerFunc {
-	srv := handler.New(graph.NewExecutableSchema(graph.Config{Resolvers: resolver}))
-	srv.Use(extension.Introspection{})
+const (
+	// How often idle subscription sockets are pinged, so proxies keep them
+	// open and dead clients are noticed.
+	websocketKeepAlive = 10 * time.Second
+	// How long a new socket may take to send connection_init.
+	websocketInitTimeout = 10 * time.Second
+)
+
+// Config bounds what a single operation may ask for. A zero limit or cache
+// size disables that feature.
+type Config struct {
+	ComplexityLimit int
+	DepthLimit      int
+	Introspection   bool
+	// APQCacheSize is how many automatic persisted queries are remembered.
+	APQCacheSize int
+	// PersistedQueriesOnly rejects every query not in graph.PersistedQueries
+	// and replaces APQ, so clients cannot register their own.
+	PersistedQueriesOnly bool
+	// RootFields limits operations to these root fields; empty allows all.
+	RootFields []string
+	// Shutdown closes every subscription socket when it is closed.
+	// http.Server.Shutdown neither waits for nor closes hijacked connections.
+	Shutdown <-chan struct{}
+}
+
+// GraphqlHandler serves queries and mutations over POST and subscriptions
+// over WebSocket. wsInit authenticates each WebSocket connection, because
+// the upgrade request carries no credentials.
+func GraphqlHandler(resolver *graph.Resolver, cfg Config, wsInit transport.WebsocketInitFunc) gin.HandlerFunc {
+	srv := handler.New(costSchema{graph.NewExecutableSchema(graph.Config{Resolvers: resolver})})
+	srv.SetErrorPresenter(errorPresenter)
+	srv.AroundOperations(func(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler {
+		return next(graph.WithMovieLoader(ctx, resolver.MovieUsecase))
+	})
+	if cfg.Introspection {
+		srv.Use(extension.Introspection{})
+	}
+	if cfg.ComplexityLimit > 0 {
+		srv.Use(extension.FixedComplexityLimit(cfg.ComplexityLimit))
+	}
+	if cfg.DepthLimit > 0 {
+		srv.Use(depthLimit{limit: cfg.DepthLimit})
+	}
+	if len(cfg.RootFields) > 0 {
+		srv.Use(rootFieldAllowlist{fields: cfg.RootFields})
+	}
+	if cfg.PersistedQueriesOnly {
+		srv.Use(&persistedQueryAllowlist{manifest: graph.PersistedQueries})
+	} else if cfg.APQCacheSize > 0 {
+		srv.Use(extension.AutomaticPersistedQuery{Cache: lru.New[string](cfg.APQCacheSize)})
+	}
+	srv.AddTransport(transport.Websocket{
+		InitFunc:    endOnShutdown(wsInit, cfg.Shutdown),
+		InitTimeout: websocketInitTimeout,
+		// graphql-transport-ws ping/pong, and `ka` messages for clients still
+		// on the legacy graphql-ws subprotocol.
+		PingPongInterval:      websocketKeepAlive,
+		KeepAlivePingInterval: websocketKeepAlive,
+	})
 	srv.AddTransport(transport.POST{})
-	srv.AddTransport(transport.GET{})
 	srv.AddTransport(transport.Options{})
 	return func(c *gin.Context) {
 		srv.ServeHTTP(c.Writer, c.Request)
 	}
 }
 
+// endOnShutdown wraps init so the connection context, which gqlgen closes
+// the socket on and subscription resolvers run under, is also cancelled when
+// shutdown is closed.
+func endOnShutdown(init transport.WebsocketInitFunc, shutdown <-chan struct{}) transport.WebsocketInitFunc {
+	return func(ctx context.Context, payload transport.InitPayload) (context.Context, *transport.InitPayload, error) {
+		var ack *transport.InitPayload
+		if init != nil {
+			var err error
+			if ctx, ack, err = init(ctx, payload); err != nil {
+				return ctx, nil, err
+			}
+		}
+		ctx, cancel := context.WithCancel(ctx)
+		go func() {
+			select {
+			case <-shutdown:
+			case <-ctx.Done():
+			}
+			cancel()
+		}()
+		return ctx, ack, nil
+	}
+}
+
 func PlaygroundHandler() gin.HandlerFunc {
 	h := playground.Handler("GraphQL", "/graphql")
 	return func(c *gin.Context) {
@@ -0,0 +1,37 @@
+package graphql
+
+import (
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	"github.com/sorrawichYooboon/go-protocol-api-style/graph"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGraphqlHandler_ShutdownClosesSockets(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	shutdown := make(chan struct{})
+	r := gin.New()
+	r.GET("/graphql", GraphqlHandler(&graph.Resolver{}, Config{Shutdown: shutdown}, nil))
+	server := httptest.NewServer(r)
+	defer server.Close()
+
+	dialer := websocket.Dialer{Subprotocols: []string{"graphql-transport-ws"}}
+	conn, _, err := dialer.Dial("ws"+strings.TrimPrefix(server.URL, "http")+"/graphql", nil)
+	require.NoError(t, err)
+	defer conn.Close()
+	require.NoError(t, conn.WriteJSON(map[string]any{"type": "connection_init"}))
+	var ack map[string]any
+	require.NoError(t, conn.ReadJSON(&ack))
+	require.Equal(t, "connection_ack", ack["type"])
+
+	close(shutdown)
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(5*time.Second)))
+	_, _, err = conn.ReadMessage()
+	assert.True(t, websocket.IsCloseError(err, websocket.CloseNormalClosure), "got %v", err)
+}
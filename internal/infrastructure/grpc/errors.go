@@ -0,0 +1,86 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/sorrawichYooboon/go-protocol-api-style/internal/usecase"
+	"github.com/sorrawichYooboon/go-protocol-api-style/logger"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+const errorDomain = "movie.MovieService"
+
+var grpcCodes = map[usecase.ErrorCode]codes.Code{
+	usecase.ErrCodeNotFound:          codes.NotFound,
+	usecase.ErrCodeInvalidArgument:   codes.InvalidArgument,
+	usecase.ErrCodeConflict:          codes.AlreadyExists,
+	usecase.ErrCodeUnauthenticated:   codes.Unauthenticated,
+	usecase.ErrCodePermissionDenied:  codes.PermissionDenied,
+	usecase.ErrCodeUnavailable:       codes.Unavailable,
+	usecase.ErrCodeResourceExhausted: codes.ResourceExhausted,
+	usecase.ErrCodeInternal:          codes.Internal,
+}
+
+// ErrorUnaryInterceptor converts usecase errors returned by handlers into
+// gRPC statuses so the handlers themselves can just return err.
+func ErrorUnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		resp, err := handler(ctx, req)
+		if err != nil {
+			return nil, toStatusError(info.FullMethod, err)
+		}
+		return resp, nil
+	}
+}
+
+func ErrorStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := handler(srv, ss); err != nil {
+			return toStatusError(info.FullMethod, err)
+		}
+		return nil
+	}
+}
+
+func toStatusError(method string, err error) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return status.FromContextError(err).Err()
+	}
+
+	ucErr := usecase.AsError(err)
+	if ucErr.Code == usecase.ErrCodeInternal {
+		logger.LogError(method, err)
+	}
+
+	st := status.New(grpcCodes[ucErr.Code], ucErr.Message)
+	details := []protoadapt.MessageV1{&errdetails.ErrorInfo{
+		Reason: string(ucErr.Code),
+		Domain: errorDomain,
+	}}
+	if len(ucErr.Violations) > 0 {
+		badRequest := &errdetails.BadRequest{}
+		for _, v := range ucErr.Violations {
+			badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+				Field:       v.Field,
+				Description: v.Description,
+			})
+		}
+		details = append(details, badRequest)
+	}
+	if ucErr.RetryAfter > 0 {
+		details = append(details, &errdetails.RetryInfo{RetryDelay: durationpb.New(ucErr.RetryAfter)})
+	}
+	if withDetails, err := st.WithDetails(details...); err == nil {
+		st = withDetails
+	}
+	return st.Err()
+}
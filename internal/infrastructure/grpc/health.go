@@ -0,0 +1,70 @@
+package grpc
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/sorrawichYooboon/go-protocol-api-style/internal/domain"
+	"github.com/sorrawichYooboon/go-protocol-api-style/internal/infrastructure/grpc/moviepb"
+	"github.com/sorrawichYooboon/go-protocol-api-style/internal/usecase"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// HealthReporter keeps the standard grpc.health.v1 service in sync with the
+// readiness checks. It is run by the lifecycle manager next to the servers.
+type HealthReporter struct {
+	Server *health.Server
+
+	healthUsecase usecase.HealthUsecase
+	interval      time.Duration
+	stop          chan struct{}
+	stopOnce      sync.Once
+}
+
+func NewHealthReporter(healthUsecase usecase.HealthUsecase, interval time.Duration) *HealthReporter {
+	return &HealthReporter{
+		Server:        health.NewServer(),
+		healthUsecase: healthUsecase,
+		interval:      interval,
+		stop:          make(chan struct{}),
+	}
+}
+
+func (r *HealthReporter) Name() string {
+	return "grpc health reporter"
+}
+
+func (r *HealthReporter) Start() error {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		r.update()
+		select {
+		case <-r.stop:
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// Shutdown flips every service to NOT_SERVING so clients stop routing new
+// calls here while the gRPC server drains.
+func (r *HealthReporter) Shutdown(ctx context.Context) error {
+	r.stopOnce.Do(func() {
+		r.Server.Shutdown()
+		close(r.stop)
+	})
+	return nil
+}
+
+func (r *HealthReporter) update() {
+	status := healthpb.HealthCheckResponse_SERVING
+	if r.healthUsecase.Readiness(context.Background()).Status != domain.HealthStatusUp {
+		status = healthpb.HealthCheckResponse_NOT_SERVING
+	}
+	r.Server.SetServingStatus("", status)
+	r.Server.SetServingStatus(moviepb.MovieService_ServiceDesc.ServiceName, status)
+}
@@ -7,6 +7,7 @@
 package moviepb
 
 import (
+	date "google.golang.org/genproto/googleapis/type/date"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -26,7 +27,7 @@ type Movie struct {
 	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
 	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	ReleaseDate   string                 `protobuf:"bytes,4,opt,name=release_date,json=releaseDate,proto3" json:"release_date,omitempty"`
+	ReleasedOn    *date.Date             `protobuf:"bytes,5,opt,name=released_on,json=releasedOn,proto3" json:"released_on,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -82,11 +83,11 @@ func (x *Movie) GetDescription() string {
 	return ""
 }
 
-func (x *Movie) GetReleaseDate() string {
+func (x *Movie) GetReleasedOn() *date.Date {
 	if x != nil {
-		return x.ReleaseDate
+		return x.ReleasedOn
 	}
-	return ""
+	return nil
 }
 
 type GetMovieRequest struct {
@@ -177,15 +178,84 @@ func (x *GetMovieResponse) GetMovie() *Movie {
 	return nil
 }
 
+type MovieFilter struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Case-insensitive substring of the title.
+	TitleContains string `protobuf:"bytes,1,opt,name=title_contains,json=titleContains,proto3" json:"title_contains,omitempty"`
+	// Inclusive bounds on the release date. Unset bounds are open.
+	ReleasedFrom  *date.Date `protobuf:"bytes,2,opt,name=released_from,json=releasedFrom,proto3" json:"released_from,omitempty"`
+	ReleasedTo    *date.Date `protobuf:"bytes,3,opt,name=released_to,json=releasedTo,proto3" json:"released_to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MovieFilter) Reset() {
+	*x = MovieFilter{}
+	mi := &file_proto_movie_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MovieFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MovieFilter) ProtoMessage() {}
+
+func (x *MovieFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_movie_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MovieFilter.ProtoReflect.Descriptor instead.
+func (*MovieFilter) Descriptor() ([]byte, []int) {
+	return file_proto_movie_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *MovieFilter) GetTitleContains() string {
+	if x != nil {
+		return x.TitleContains
+	}
+	return ""
+}
+
+func (x *MovieFilter) GetReleasedFrom() *date.Date {
+	if x != nil {
+		return x.ReleasedFrom
+	}
+	return nil
+}
+
+func (x *MovieFilter) GetReleasedTo() *date.Date {
+	if x != nil {
+		return x.ReleasedTo
+	}
+	return nil
+}
+
 type ListMoviesRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	PageSize  int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	Filter    *MovieFilter           `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	// A field name, "id", "title" or "released_on", optionally followed by
+	// " desc", e.g. "released_on desc". Defaults to "id". A page token is only
+	// valid with the order_by it was returned for.
+	OrderBy       string `protobuf:"bytes,4,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListMoviesRequest) Reset() {
 	*x = ListMoviesRequest{}
-	mi := &file_proto_movie_proto_msgTypes[3]
+	mi := &file_proto_movie_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -197,7 +267,7 @@ func (x *ListMoviesRequest) String() string {
 func (*ListMoviesRequest) ProtoMessage() {}
 
 func (x *ListMoviesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_movie_proto_msgTypes[3]
+	mi := &file_proto_movie_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -210,19 +280,48 @@ func (x *ListMoviesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListMoviesRequest.ProtoReflect.Descriptor instead.
 func (*ListMoviesRequest) Descriptor() ([]byte, []int) {
-	return file_proto_movie_proto_rawDescGZIP(), []int{3}
+	return file_proto_movie_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListMoviesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListMoviesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListMoviesRequest) GetFilter() *MovieFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ListMoviesRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
 }
 
 type ListMoviesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Movies        []*Movie               `protobuf:"bytes,1,rep,name=movies,proto3" json:"movies,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListMoviesResponse) Reset() {
 	*x = ListMoviesResponse{}
-	mi := &file_proto_movie_proto_msgTypes[4]
+	mi := &file_proto_movie_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -234,7 +333,7 @@ func (x *ListMoviesResponse) String() string {
 func (*ListMoviesResponse) ProtoMessage() {}
 
 func (x *ListMoviesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_movie_proto_msgTypes[4]
+	mi := &file_proto_movie_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -247,7 +346,7 @@ func (x *ListMoviesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListMoviesResponse.ProtoReflect.Descriptor instead.
 func (*ListMoviesResponse) Descriptor() ([]byte, []int) {
-	return file_proto_movie_proto_rawDescGZIP(), []int{4}
+	return file_proto_movie_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *ListMoviesResponse) GetMovies() []*Movie {
@@ -257,27 +356,458 @@ func (x *ListMoviesResponse) GetMovies() []*Movie {
 	return nil
 }
 
+func (x *ListMoviesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type StreamMoviesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamMoviesRequest) Reset() {
+	*x = StreamMoviesRequest{}
+	mi := &file_proto_movie_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamMoviesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamMoviesRequest) ProtoMessage() {}
+
+func (x *StreamMoviesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_movie_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamMoviesRequest.ProtoReflect.Descriptor instead.
+func (*StreamMoviesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_movie_proto_rawDescGZIP(), []int{6}
+}
+
+type StreamMoviesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Movie         *Movie                 `protobuf:"bytes,1,opt,name=movie,proto3" json:"movie,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamMoviesResponse) Reset() {
+	*x = StreamMoviesResponse{}
+	mi := &file_proto_movie_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamMoviesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamMoviesResponse) ProtoMessage() {}
+
+func (x *StreamMoviesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_movie_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamMoviesResponse.ProtoReflect.Descriptor instead.
+func (*StreamMoviesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_movie_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *StreamMoviesResponse) GetMovie() *Movie {
+	if x != nil {
+		return x.Movie
+	}
+	return nil
+}
+
+type CreateMovieRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Title       string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// A full date; partial dates are rejected.
+	ReleasedOn    *date.Date `protobuf:"bytes,4,opt,name=released_on,json=releasedOn,proto3" json:"released_on,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateMovieRequest) Reset() {
+	*x = CreateMovieRequest{}
+	mi := &file_proto_movie_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateMovieRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateMovieRequest) ProtoMessage() {}
+
+func (x *CreateMovieRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_movie_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateMovieRequest.ProtoReflect.Descriptor instead.
+func (*CreateMovieRequest) Descriptor() ([]byte, []int) {
+	return file_proto_movie_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CreateMovieRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateMovieRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateMovieRequest) GetReleasedOn() *date.Date {
+	if x != nil {
+		return x.ReleasedOn
+	}
+	return nil
+}
+
+type CreateMovieResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Movie         *Movie                 `protobuf:"bytes,1,opt,name=movie,proto3" json:"movie,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateMovieResponse) Reset() {
+	*x = CreateMovieResponse{}
+	mi := &file_proto_movie_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateMovieResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateMovieResponse) ProtoMessage() {}
+
+func (x *CreateMovieResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_movie_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateMovieResponse.ProtoReflect.Descriptor instead.
+func (*CreateMovieResponse) Descriptor() ([]byte, []int) {
+	return file_proto_movie_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CreateMovieResponse) GetMovie() *Movie {
+	if x != nil {
+		return x.Movie
+	}
+	return nil
+}
+
+type UpdateMovieRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title       string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// A full date; partial dates are rejected.
+	ReleasedOn    *date.Date `protobuf:"bytes,5,opt,name=released_on,json=releasedOn,proto3" json:"released_on,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateMovieRequest) Reset() {
+	*x = UpdateMovieRequest{}
+	mi := &file_proto_movie_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateMovieRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMovieRequest) ProtoMessage() {}
+
+func (x *UpdateMovieRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_movie_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMovieRequest.ProtoReflect.Descriptor instead.
+func (*UpdateMovieRequest) Descriptor() ([]byte, []int) {
+	return file_proto_movie_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *UpdateMovieRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateMovieRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *UpdateMovieRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UpdateMovieRequest) GetReleasedOn() *date.Date {
+	if x != nil {
+		return x.ReleasedOn
+	}
+	return nil
+}
+
+type UpdateMovieResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Movie         *Movie                 `protobuf:"bytes,1,opt,name=movie,proto3" json:"movie,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateMovieResponse) Reset() {
+	*x = UpdateMovieResponse{}
+	mi := &file_proto_movie_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateMovieResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMovieResponse) ProtoMessage() {}
+
+func (x *UpdateMovieResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_movie_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMovieResponse.ProtoReflect.Descriptor instead.
+func (*UpdateMovieResponse) Descriptor() ([]byte, []int) {
+	return file_proto_movie_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *UpdateMovieResponse) GetMovie() *Movie {
+	if x != nil {
+		return x.Movie
+	}
+	return nil
+}
+
+type DeleteMovieRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteMovieRequest) Reset() {
+	*x = DeleteMovieRequest{}
+	mi := &file_proto_movie_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMovieRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMovieRequest) ProtoMessage() {}
+
+func (x *DeleteMovieRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_movie_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMovieRequest.ProtoReflect.Descriptor instead.
+func (*DeleteMovieRequest) Descriptor() ([]byte, []int) {
+	return file_proto_movie_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *DeleteMovieRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteMovieResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Movie         *Movie                 `protobuf:"bytes,1,opt,name=movie,proto3" json:"movie,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteMovieResponse) Reset() {
+	*x = DeleteMovieResponse{}
+	mi := &file_proto_movie_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMovieResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMovieResponse) ProtoMessage() {}
+
+func (x *DeleteMovieResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_movie_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMovieResponse.ProtoReflect.Descriptor instead.
+func (*DeleteMovieResponse) Descriptor() ([]byte, []int) {
+	return file_proto_movie_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *DeleteMovieResponse) GetMovie() *Movie {
+	if x != nil {
+		return x.Movie
+	}
+	return nil
+}
+
 var File_proto_movie_proto protoreflect.FileDescriptor
 
 const file_proto_movie_proto_rawDesc = "" +
 	"\n" +
-	"\x11proto/movie.proto\x12\x05movie\"r\n" +
+	"\x11proto/movie.proto\x12\x05movie\x1a\x16google/type/date.proto\"\x97\x01\n" +
 	"\x05Movie\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
-	"\vdescription\x18\x03 \x01(\tR\vdescription\x12!\n" +
-	"\frelease_date\x18\x04 \x01(\tR\vreleaseDate\"!\n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x122\n" +
+	"\vreleased_on\x18\x05 \x01(\v2\x11.google.type.DateR\n" +
+	"releasedOnJ\x04\b\x04\x10\x05R\frelease_date\"!\n" +
 	"\x0fGetMovieRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\"6\n" +
 	"\x10GetMovieResponse\x12\"\n" +
-	"\x05movie\x18\x01 \x01(\v2\f.movie.MovieR\x05movie\"\x13\n" +
-	"\x11ListMoviesRequest\":\n" +
+	"\x05movie\x18\x01 \x01(\v2\f.movie.MovieR\x05movie\"\xa0\x01\n" +
+	"\vMovieFilter\x12%\n" +
+	"\x0etitle_contains\x18\x01 \x01(\tR\rtitleContains\x126\n" +
+	"\rreleased_from\x18\x02 \x01(\v2\x11.google.type.DateR\freleasedFrom\x122\n" +
+	"\vreleased_to\x18\x03 \x01(\v2\x11.google.type.DateR\n" +
+	"releasedTo\"\x96\x01\n" +
+	"\x11ListMoviesRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12*\n" +
+	"\x06filter\x18\x03 \x01(\v2\x12.movie.MovieFilterR\x06filter\x12\x19\n" +
+	"\border_by\x18\x04 \x01(\tR\aorderBy\"b\n" +
 	"\x12ListMoviesResponse\x12$\n" +
-	"\x06movies\x18\x01 \x03(\v2\f.movie.MovieR\x06movies2\x8e\x01\n" +
+	"\x06movies\x18\x01 \x03(\v2\f.movie.MovieR\x06movies\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x15\n" +
+	"\x13StreamMoviesRequest\":\n" +
+	"\x14StreamMoviesResponse\x12\"\n" +
+	"\x05movie\x18\x01 \x01(\v2\f.movie.MovieR\x05movie\"\x94\x01\n" +
+	"\x12CreateMovieRequest\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x122\n" +
+	"\vreleased_on\x18\x04 \x01(\v2\x11.google.type.DateR\n" +
+	"releasedOnJ\x04\b\x03\x10\x04R\frelease_date\"9\n" +
+	"\x13CreateMovieResponse\x12\"\n" +
+	"\x05movie\x18\x01 \x01(\v2\f.movie.MovieR\x05movie\"\xa4\x01\n" +
+	"\x12UpdateMovieRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x122\n" +
+	"\vreleased_on\x18\x05 \x01(\v2\x11.google.type.DateR\n" +
+	"releasedOnJ\x04\b\x04\x10\x05R\frelease_date\"9\n" +
+	"\x13UpdateMovieResponse\x12\"\n" +
+	"\x05movie\x18\x01 \x01(\v2\f.movie.MovieR\x05movie\"$\n" +
+	"\x12DeleteMovieRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"9\n" +
+	"\x13DeleteMovieResponse\x12\"\n" +
+	"\x05movie\x18\x01 \x01(\v2\f.movie.MovieR\x05movie2\xab\x03\n" +
 	"\fMovieService\x12;\n" +
 	"\bGetMovie\x12\x16.movie.GetMovieRequest\x1a\x17.movie.GetMovieResponse\x12A\n" +
 	"\n" +
-	"ListMovies\x12\x18.movie.ListMoviesRequest\x1a\x19.movie.ListMoviesResponseB&Z$internal/infrastructure/grpc/moviepbb\x06proto3"
+	"ListMovies\x12\x18.movie.ListMoviesRequest\x1a\x19.movie.ListMoviesResponse\x12I\n" +
+	"\fStreamMovies\x12\x1a.movie.StreamMoviesRequest\x1a\x1b.movie.StreamMoviesResponse0\x01\x12D\n" +
+	"\vCreateMovie\x12\x19.movie.CreateMovieRequest\x1a\x1a.movie.CreateMovieResponse\x12D\n" +
+	"\vUpdateMovie\x12\x19.movie.UpdateMovieRequest\x1a\x1a.movie.UpdateMovieResponse\x12D\n" +
+	"\vDeleteMovie\x12\x19.movie.DeleteMovieRequest\x1a\x1a.movie.DeleteMovieResponseB&Z$internal/infrastructure/grpc/moviepbb\x06proto3"
 
 var (
 	file_proto_movie_proto_rawDescOnce sync.Once
@@ -291,26 +821,54 @@ func file_proto_movie_proto_rawDescGZIP() []byte {
 	return file_proto_movie_proto_rawDescData
 }
 
-var file_proto_movie_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_proto_movie_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
 var file_proto_movie_proto_goTypes = []any{
-	(*Movie)(nil),              // 0: movie.Movie
-	(*GetMovieRequest)(nil),    // 1: movie.GetMovieRequest
-	(*GetMovieResponse)(nil),   // 2: movie.GetMovieResponse
-	(*ListMoviesRequest)(nil),  // 3: movie.ListMoviesRequest
-	(*ListMoviesResponse)(nil), // 4: movie.ListMoviesResponse
+	(*Movie)(nil),                // 0: movie.Movie
+	(*GetMovieRequest)(nil),      // 1: movie.GetMovieRequest
+	(*GetMovieResponse)(nil),     // 2: movie.GetMovieResponse
+	(*MovieFilter)(nil),          // 3: movie.MovieFilter
+	(*ListMoviesRequest)(nil),    // 4: movie.ListMoviesRequest
+	(*ListMoviesResponse)(nil),   // 5: movie.ListMoviesResponse
+	(*StreamMoviesRequest)(nil),  // 6: movie.StreamMoviesRequest
+	(*StreamMoviesResponse)(nil), // 7: movie.StreamMoviesResponse
+	(*CreateMovieRequest)(nil),   // 8: movie.CreateMovieRequest
+	(*CreateMovieResponse)(nil),  // 9: movie.CreateMovieResponse
+	(*UpdateMovieRequest)(nil),   // 10: movie.UpdateMovieRequest
+	(*UpdateMovieResponse)(nil),  // 11: movie.UpdateMovieResponse
+	(*DeleteMovieRequest)(nil),   // 12: movie.DeleteMovieRequest
+	(*DeleteMovieResponse)(nil),  // 13: movie.DeleteMovieResponse
+	(*date.Date)(nil),            // 14: google.type.Date
 }
 var file_proto_movie_proto_depIdxs = []int32{
-	0, // 0: movie.GetMovieResponse.movie:type_name -> movie.Movie
-	0, // 1: movie.ListMoviesResponse.movies:type_name -> movie.Movie
-	1, // 2: movie.MovieService.GetMovie:input_type -> movie.GetMovieRequest
-	3, // 3: movie.MovieService.ListMovies:input_type -> movie.ListMoviesRequest
-	2, // 4: movie.MovieService.GetMovie:output_type -> movie.GetMovieResponse
-	4, // 5: movie.MovieService.ListMovies:output_type -> movie.ListMoviesResponse
-	4, // [4:6] is the sub-list for method output_type
-	2, // [2:4] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	14, // 0: movie.Movie.released_on:type_name -> google.type.Date
+	0,  // 1: movie.GetMovieResponse.movie:type_name -> movie.Movie
+	14, // 2: movie.MovieFilter.released_from:type_name -> google.type.Date
+	14, // 3: movie.MovieFilter.released_to:type_name -> google.type.Date
+	3,  // 4: movie.ListMoviesRequest.filter:type_name -> movie.MovieFilter
+	0,  // 5: movie.ListMoviesResponse.movies:type_name -> movie.Movie
+	0,  // 6: movie.StreamMoviesResponse.movie:type_name -> movie.Movie
+	14, // 7: movie.CreateMovieRequest.released_on:type_name -> google.type.Date
+	0,  // 8: movie.CreateMovieResponse.movie:type_name -> movie.Movie
+	14, // 9: movie.UpdateMovieRequest.released_on:type_name -> google.type.Date
+	0,  // 10: movie.UpdateMovieResponse.movie:type_name -> movie.Movie
+	0,  // 11: movie.DeleteMovieResponse.movie:type_name -> movie.Movie
+	1,  // 12: movie.MovieService.GetMovie:input_type -> movie.GetMovieRequest
+	4,  // 13: movie.MovieService.ListMovies:input_type -> movie.ListMoviesRequest
+	6,  // 14: movie.MovieService.StreamMovies:input_type -> movie.StreamMoviesRequest
+	8,  // 15: movie.MovieService.CreateMovie:input_type -> movie.CreateMovieRequest
+	10, // 16: movie.MovieService.UpdateMovie:input_type -> movie.UpdateMovieRequest
+	12, // 17: movie.MovieService.DeleteMovie:input_type -> movie.DeleteMovieRequest
+	2,  // 18: movie.MovieService.GetMovie:output_type -> movie.GetMovieResponse
+	5,  // 19: movie.MovieService.ListMovies:output_type -> movie.ListMoviesResponse
+	7,  // 20: movie.MovieService.StreamMovies:output_type -> movie.StreamMoviesResponse
+	9,  // 21: movie.MovieService.CreateMovie:output_type -> movie.CreateMovieResponse
+	11, // 22: movie.MovieService.UpdateMovie:output_type -> movie.UpdateMovieResponse
+	13, // 23: movie.MovieService.DeleteMovie:output_type -> movie.DeleteMovieResponse
+	18, // [18:24] is the sub-list for method output_type
+	12, // [12:18] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_proto_movie_proto_init() }
@@ -324,7 +882,7 @@ func file_proto_movie_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_movie_proto_rawDesc), len(file_proto_movie_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   14,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -2,9 +2,14 @@ package grpc
 
 import (
 	"context"
+	"strings"
+	"time"
 
+	"github.com/sorrawichYooboon/go-protocol-api-style/internal/domain"
 	"github.com/sorrawichYooboon/go-protocol-api-style/internal/infrastructure/grpc/moviepb"
 	"github.com/sorrawichYooboon/go-protocol-api-style/internal/usecase"
+	"google.golang.org/genproto/googleapis/type/date"
+	"google.golang.org/grpc"
 )
 
 type MovieServer struct {
@@ -17,33 +22,160 @@ func NewMovieServer(usecase usecase.MovieUsecase) *MovieServer {
 }
 
 func (s *MovieServer) GetMovie(ctx context.Context, req *moviepb.GetMovieRequest) (*moviepb.GetMovieResponse, error) {
-	movie, err := s.MovieUsecase.GetMovieByID(req.Id)
-	if err != nil || movie == nil {
+	movie, err := s.MovieUsecase.GetMovieByID(ctx, req.Id)
+	if err != nil {
 		return nil, err
 	}
 	return &moviepb.GetMovieResponse{
-		Movie: &moviepb.Movie{
-			Id:          movie.ID,
-			Title:       movie.Title,
-			Description: movie.Description,
-			ReleaseDate: movie.ReleaseDate,
-		},
+		Movie: toMoviePB(movie),
 	}, nil
 }
 
 func (s *MovieServer) ListMovies(ctx context.Context, req *moviepb.ListMoviesRequest) (*moviepb.ListMoviesResponse, error) {
-	movies, err := s.MovieUsecase.GetAllMovies()
+	sort, err := parseOrderBy(req.OrderBy)
 	if err != nil {
 		return nil, err
 	}
-	resp := &moviepb.ListMoviesResponse{}
-	for _, m := range movies {
-		resp.Movies = append(resp.Movies, &moviepb.Movie{
-			Id:          m.ID,
-			Title:       m.Title,
-			Description: m.Description,
-			ReleaseDate: m.ReleaseDate,
-		})
+	page, err := s.MovieUsecase.ListMovies(ctx, fromMovieFilterPB(req.Filter), sort, int(req.PageSize), req.PageToken)
+	if err != nil {
+		return nil, usecase.RenameViolations(err, listMoviesFields)
+	}
+	resp := &moviepb.ListMoviesResponse{NextPageToken: page.NextCursor}
+	for _, e := range page.Edges {
+		resp.Movies = append(resp.Movies, toMoviePB(&e.Movie))
 	}
 	return resp, nil
 }
+
+func (s *MovieServer) StreamMovies(req *moviepb.StreamMoviesRequest, stream grpc.ServerStreamingServer[moviepb.StreamMoviesResponse]) error {
+	// Send blocks on HTTP/2 flow control, so a slow client throttles the
+	// underlying row iteration instead of buffering the catalog in memory.
+	return s.MovieUsecase.StreamMovies(stream.Context(), func(m *domain.Movie) error {
+		return stream.Send(&moviepb.StreamMoviesResponse{Movie: toMoviePB(m)})
+	})
+}
+
+func (s *MovieServer) CreateMovie(ctx context.Context, req *moviepb.CreateMovieRequest) (*moviepb.CreateMovieResponse, error) {
+	movie, err := s.MovieUsecase.CreateMovie(ctx, &domain.Movie{
+		Title:       req.Title,
+		Description: req.Description,
+		ReleaseDate: fromDatePB(req.ReleasedOn),
+	})
+	if err != nil {
+		return nil, usecase.RenameViolations(err, movieFields)
+	}
+	return &moviepb.CreateMovieResponse{
+		Movie: toMoviePB(movie),
+	}, nil
+}
+
+func (s *MovieServer) UpdateMovie(ctx context.Context, req *moviepb.UpdateMovieRequest) (*moviepb.UpdateMovieResponse, error) {
+	movie, err := s.MovieUsecase.UpdateMovie(ctx, &domain.Movie{
+		ID:          req.Id,
+		Title:       req.Title,
+		Description: req.Description,
+		ReleaseDate: fromDatePB(req.ReleasedOn),
+	})
+	if err != nil {
+		return nil, usecase.RenameViolations(err, movieFields)
+	}
+	return &moviepb.UpdateMovieResponse{
+		Movie: toMoviePB(movie),
+	}, nil
+}
+
+func (s *MovieServer) DeleteMovie(ctx context.Context, req *moviepb.DeleteMovieRequest) (*moviepb.DeleteMovieResponse, error) {
+	movie, err := s.MovieUsecase.DeleteMovie(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &moviepb.DeleteMovieResponse{
+		Movie: toMoviePB(movie),
+	}, nil
+}
+
+func toMoviePB(m *domain.Movie) *moviepb.Movie {
+	return &moviepb.Movie{
+		Id:          m.ID,
+		Title:       m.Title,
+		Description: m.Description,
+		ReleasedOn:  toDatePB(m.ReleaseDate),
+	}
+}
+
+func toDatePB(d domain.Date) *date.Date {
+	if d.IsZero() {
+		return nil
+	}
+	return &date.Date{Year: int32(d.Year), Month: int32(d.Month), Day: int32(d.Day)}
+}
+
+func fromMovieFilterPB(f *moviepb.MovieFilter) domain.MovieFilter {
+	return domain.MovieFilter{
+		TitleContains: f.GetTitleContains(),
+		ReleasedFrom:  fromDatePB(f.GetReleasedFrom()),
+		ReleasedTo:    fromDatePB(f.GetReleasedTo()),
+	}
+}
+
+// movieFields and listMoviesFields map usecase field names to the field
+// paths of the request messages, as google.rpc.BadRequest expects.
+var (
+	movieFields = map[string]string{
+		"releaseDate": "released_on",
+	}
+	listMoviesFields = map[string]string{
+		"titleContains": "filter.title_contains",
+		"releasedFrom":  "filter.released_from",
+		"releasedTo":    "filter.released_to",
+		"pageSize":      "page_size",
+		"cursor":        "page_token",
+	}
+)
+
+var orderByFields = map[string]domain.MovieSortField{
+	"id":          domain.MovieSortByID,
+	"title":       domain.MovieSortByTitle,
+	"released_on": domain.MovieSortByReleaseDate,
+}
+
+// parseOrderBy parses an AIP-132 style order_by holding a single field, e.g.
+// "released_on desc".
+func parseOrderBy(orderBy string) (domain.MovieSort, error) {
+	invalid := usecase.NewInvalidArgumentError("invalid order_by", usecase.FieldViolation{
+		Field:       "order_by",
+		Description: `must be "id", "title" or "released_on", optionally followed by " asc" or " desc"`,
+	})
+
+	parts := strings.Fields(orderBy)
+	if len(parts) == 0 {
+		return domain.MovieSort{}, nil
+	}
+	if len(parts) > 2 {
+		return domain.MovieSort{}, invalid
+	}
+	field, ok := orderByFields[parts[0]]
+	if !ok {
+		return domain.MovieSort{}, invalid
+	}
+	sort := domain.MovieSort{Field: field}
+	if len(parts) == 2 {
+		switch parts[1] {
+		case "asc":
+		case "desc":
+			sort.Descending = true
+		default:
+			return domain.MovieSort{}, invalid
+		}
+	}
+	return sort, nil
+}
+
+// fromDatePB keeps partial dates, e.g. a zero day, as they are so the usecase
+// rejects them as invalid instead of treating them as missing.
+func fromDatePB(d *date.Date) domain.Date {
+	if d == nil {
+		return domain.Date{}
+	}
+	return domain.Date{Year: int(d.Year), Month: time.Month(d.Month), Day: int(d.Day)}
+}
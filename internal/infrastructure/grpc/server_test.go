@@ -0,0 +1,161 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sorrawichYooboon/go-protocol-api-style/internal/domain"
+	"github.com/sorrawichYooboon/go-protocol-api-style/internal/infrastructure/grpc/moviepb"
+	mockRepo "github.com/sorrawichYooboon/go-protocol-api-style/internal/mock"
+	"github.com/sorrawichYooboon/go-protocol-api-style/internal/usecase"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/genproto/googleapis/type/date"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestMovieServer_WriteOperations(t *testing.T) {
+	mockMovieRepo := mockRepo.NewMovieRepository(t)
+	mockMovieEventBus := mockRepo.NewMovieEventBus(t)
+
+	clearAllMock := func() {
+		mockMovieRepo.ClearAll()
+		mockMovieEventBus.ClearAll()
+	}
+
+	server := NewMovieServer(usecase.NewMovieUsecase(mockMovieRepo, nil, mockMovieEventBus))
+	ctx := usecase.WithPrincipal(context.Background(), &domain.Principal{
+		Subject: "apikey:1",
+		Name:    "test",
+		Scopes:  []string{usecase.ScopeMoviesRead, usecase.ScopeMoviesWrite},
+	})
+
+	dune := &domain.Movie{ID: 4, Title: "Dune", ReleaseDate: domain.Date{Year: 2021, Month: time.October, Day: 22}}
+	releasedOn := &date.Date{Year: 2021, Month: 10, Day: 22}
+	dunePB := &moviepb.Movie{Id: 4, Title: "Dune", ReleasedOn: releasedOn}
+
+	tests := []struct {
+		name string
+		call func() (*moviepb.Movie, error)
+
+		wantServiceOrRepoCallWithAndResponse func()
+		wantCode                             codes.Code
+		wantViolationFields                  []string
+		wantMovie                            *moviepb.Movie
+	}{
+		{
+			name: "create with partial release date",
+			call: func() (*moviepb.Movie, error) {
+				resp, err := server.CreateMovie(ctx, &moviepb.CreateMovieRequest{Title: "Dune", ReleasedOn: &date.Date{Year: 2021}})
+				return resp.GetMovie(), err
+			},
+			wantCode:            codes.InvalidArgument,
+			wantViolationFields: []string{"released_on"},
+		},
+		{
+			name: "create",
+			call: func() (*moviepb.Movie, error) {
+				resp, err := server.CreateMovie(ctx, &moviepb.CreateMovieRequest{Title: "Dune", ReleasedOn: releasedOn})
+				return resp.GetMovie(), err
+			},
+			wantServiceOrRepoCallWithAndResponse: func() {
+				mockMovieRepo.On("Create", mock.Anything, &domain.Movie{Title: "Dune", ReleaseDate: dune.ReleaseDate}).Return(dune, nil)
+				mockMovieEventBus.On("Publish", mock.Anything).Return()
+			},
+			wantCode:  codes.OK,
+			wantMovie: dunePB,
+		},
+		{
+			name: "update with empty title and no release date",
+			call: func() (*moviepb.Movie, error) {
+				resp, err := server.UpdateMovie(ctx, &moviepb.UpdateMovieRequest{Id: 4})
+				return resp.GetMovie(), err
+			},
+			wantCode:            codes.InvalidArgument,
+			wantViolationFields: []string{"title", "released_on"},
+		},
+		{
+			name: "update missing movie",
+			call: func() (*moviepb.Movie, error) {
+				resp, err := server.UpdateMovie(ctx, &moviepb.UpdateMovieRequest{Id: 7, Title: "Dune", ReleasedOn: releasedOn})
+				return resp.GetMovie(), err
+			},
+			wantServiceOrRepoCallWithAndResponse: func() {
+				mockMovieRepo.On("Update", mock.Anything, mock.Anything).Return(nil, nil)
+			},
+			wantCode: codes.NotFound,
+		},
+		{
+			name: "update",
+			call: func() (*moviepb.Movie, error) {
+				resp, err := server.UpdateMovie(ctx, &moviepb.UpdateMovieRequest{Id: 4, Title: "Dune", ReleasedOn: releasedOn})
+				return resp.GetMovie(), err
+			},
+			wantServiceOrRepoCallWithAndResponse: func() {
+				mockMovieRepo.On("Update", mock.Anything, dune).Return(dune, nil)
+				mockMovieEventBus.On("Publish", mock.Anything).Return()
+			},
+			wantCode:  codes.OK,
+			wantMovie: dunePB,
+		},
+		{
+			name: "delete missing movie",
+			call: func() (*moviepb.Movie, error) {
+				resp, err := server.DeleteMovie(ctx, &moviepb.DeleteMovieRequest{Id: 7})
+				return resp.GetMovie(), err
+			},
+			wantServiceOrRepoCallWithAndResponse: func() {
+				mockMovieRepo.On("Delete", mock.Anything, int64(7)).Return(nil, nil)
+			},
+			wantCode: codes.NotFound,
+		},
+		{
+			name: "delete",
+			call: func() (*moviepb.Movie, error) {
+				resp, err := server.DeleteMovie(ctx, &moviepb.DeleteMovieRequest{Id: 4})
+				return resp.GetMovie(), err
+			},
+			wantServiceOrRepoCallWithAndResponse: func() {
+				mockMovieRepo.On("Delete", mock.Anything, int64(4)).Return(dune, nil)
+				mockMovieEventBus.On("Publish", mock.Anything).Return()
+			},
+			wantCode:  codes.OK,
+			wantMovie: dunePB,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			defer clearAllMock()
+
+			if test.wantServiceOrRepoCallWithAndResponse != nil {
+				test.wantServiceOrRepoCallWithAndResponse()
+			}
+
+			movie, err := test.call()
+
+			if test.wantCode == codes.OK {
+				require.NoError(t, err)
+				assert.Equal(t, test.wantMovie.String(), movie.String())
+				return
+			}
+			st := status.Convert(toStatusError("/movie.MovieService/Test", err))
+			assert.Equal(t, test.wantCode, st.Code())
+			assert.Nil(t, movie)
+
+			var fields []string
+			for _, detail := range st.Details() {
+				if badRequest, ok := detail.(*errdetails.BadRequest); ok {
+					for _, v := range badRequest.FieldViolations {
+						fields = append(fields, v.Field)
+					}
+				}
+			}
+			assert.Equal(t, test.wantViolationFields, fields)
+		})
+	}
+}
@@ -0,0 +1,34 @@
+package httphandler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sorrawichYooboon/go-protocol-api-style/internal/domain"
+	"github.com/sorrawichYooboon/go-protocol-api-style/internal/usecase"
+)
+
+type HealthHandlerImpl struct {
+	healthUsecase usecase.HealthUsecase
+}
+
+func NewHealthHandler(healthUsecase usecase.HealthUsecase) HealthHandler {
+	return &HealthHandlerImpl{healthUsecase: healthUsecase}
+}
+
+func (h *HealthHandlerImpl) Liveness(c *gin.Context) {
+	writeHealthReport(c, h.healthUsecase.Liveness(c.Request.Context()))
+}
+
+func (h *HealthHandlerImpl) Readiness(c *gin.Context) {
+	writeHealthReport(c, h.healthUsecase.Readiness(c.Request.Context()))
+}
+
+func writeHealthReport(c *gin.Context, report *domain.HealthReport) {
+	status := http.StatusOK
+	if report.Status != domain.HealthStatusUp {
+		status = http.StatusServiceUnavailable
+	}
+	c.Header("Cache-Control", "no-store")
+	c.JSON(status, report)
+}
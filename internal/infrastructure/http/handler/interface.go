@@ -5,4 +5,12 @@ import "github.com/gin-gonic/gin"
 type MovieHandler interface {
 	GetMovies(*gin.Context)
 	GetMovieByID(*gin.Context)
+	CreateMovie(*gin.Context)
+	UpdateMovie(*gin.Context)
+	DeleteMovie(*gin.Context)
+}
+
+type HealthHandler interface {
+	Liveness(*gin.Context)
+	Readiness(*gin.Context)
 }
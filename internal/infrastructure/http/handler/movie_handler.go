@@ -2,9 +2,11 @@ package httphandler
 
 import (
 	"net/http"
+	"net/url"
 	"strconv"
 
 	"github.com/gin-gonic/gin"
+	"github.com/sorrawichYooboon/go-protocol-api-style/internal/domain"
 	"github.com/sorrawichYooboon/go-protocol-api-style/internal/dto"
 	"github.com/sorrawichYooboon/go-protocol-api-style/internal/usecase"
 )
@@ -18,47 +20,220 @@ func NewMovieHandler(movieUsecase usecase.MovieUsecase) MovieHandler {
 }
 
 func (h *MovieHandlerImpl) GetMovies(c *gin.Context) {
-	movies, err := h.movieUsecase.GetAllMovies()
+	limit := 0
+	if limitStr := c.Query("limit"); limitStr != "" {
+		l, err := strconv.Atoi(limitStr)
+		if err != nil || l < 1 {
+			writeProblem(c, usecase.NewInvalidArgumentError("invalid limit", usecase.FieldViolation{Field: "limit", Description: "must be a positive integer"}))
+			return
+		}
+		limit = l
+	}
+
+	filter, sort, err := parseMovieListQuery(c)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Unable to fetch movies"})
+		writeProblem(c, err)
 		return
 	}
 
-	resp := make([]dto.MovieResponse, 0, len(movies))
-	for _, m := range movies {
-		resp = append(resp, dto.MovieResponse{
-			ID:          m.ID,
-			Title:       m.Title,
-			Description: m.Description,
-			ReleaseDate: m.ReleaseDate,
-		})
+	page, err := h.movieUsecase.ListMovies(c.Request.Context(), filter, sort, limit, c.Query("cursor"))
+	if err != nil {
+		writeProblem(c, usecase.RenameViolations(err, movieQueryParams))
+		return
+	}
+
+	// The body stays the bare array clients got before pagination; the next
+	// page is advertised in an RFC 8288 Link header instead.
+	if page.NextCursor != "" {
+		c.Header("Link", "<"+nextPageURL(c.Request.URL, page.NextCursor)+`>; rel="next"`)
+	}
+	resp := make([]dto.MovieResponse, 0, len(page.Edges))
+	for _, e := range page.Edges {
+		resp = append(resp, toMovieResponse(&e.Movie))
 	}
 	c.JSON(http.StatusOK, resp)
 }
 
+// nextPageURL returns the request's path and query with cursor replaced, so
+// the filter, sort and limit carry over to the next page.
+func nextPageURL(u *url.URL, cursor string) string {
+	query := u.Query()
+	query.Set("cursor", cursor)
+	next := url.URL{Path: u.Path, RawQuery: query.Encode()}
+	return next.String()
+}
+
 func (h *MovieHandlerImpl) GetMovieByID(c *gin.Context) {
-	idStr := c.Param("id")
-	id, err := strconv.ParseInt(idStr, 10, 64)
+	id, err := parseMovieID(c)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+		writeProblem(c, err)
 		return
 	}
 
-	movie, err := h.movieUsecase.GetMovieByID(id)
+	movie, err := h.movieUsecase.GetMovieByID(c.Request.Context(), id)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Unable to fetch movie"})
+		writeProblem(c, err)
 		return
 	}
-	if movie == nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": "Movie not found"})
+
+	c.JSON(http.StatusOK, toMovieResponse(movie))
+}
+
+// movieBodyFields maps usecase field names to the JSON fields of
+// dto.MovieRequest.
+var movieBodyFields = map[string]string{
+	"releaseDate": "release_date",
+}
+
+func (h *MovieHandlerImpl) CreateMovie(c *gin.Context) {
+	var req dto.MovieRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		writeProblem(c, usecase.NewInvalidArgumentError("invalid request body"))
 		return
 	}
 
-	resp := dto.MovieResponse{
-		ID:          movie.ID,
-		Title:       movie.Title,
-		Description: movie.Description,
-		ReleaseDate: movie.ReleaseDate,
+	movie, err := toMovieDomain(0, &req)
+	if err != nil {
+		writeProblem(c, usecase.RenameViolations(err, movieBodyFields))
+		return
 	}
-	c.JSON(http.StatusOK, resp)
+
+	movie, err = h.movieUsecase.CreateMovie(c.Request.Context(), movie)
+	if err != nil {
+		writeProblem(c, usecase.RenameViolations(err, movieBodyFields))
+		return
+	}
+
+	c.JSON(http.StatusCreated, toMovieResponse(movie))
+}
+
+func (h *MovieHandlerImpl) UpdateMovie(c *gin.Context) {
+	id, err := parseMovieID(c)
+	if err != nil {
+		writeProblem(c, err)
+		return
+	}
+
+	var req dto.MovieRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		writeProblem(c, usecase.NewInvalidArgumentError("invalid request body"))
+		return
+	}
+
+	movie, err := toMovieDomain(id, &req)
+	if err != nil {
+		writeProblem(c, usecase.RenameViolations(err, movieBodyFields))
+		return
+	}
+
+	movie, err = h.movieUsecase.UpdateMovie(c.Request.Context(), movie)
+	if err != nil {
+		writeProblem(c, usecase.RenameViolations(err, movieBodyFields))
+		return
+	}
+
+	c.JSON(http.StatusOK, toMovieResponse(movie))
+}
+
+func (h *MovieHandlerImpl) DeleteMovie(c *gin.Context) {
+	id, err := parseMovieID(c)
+	if err != nil {
+		writeProblem(c, err)
+		return
+	}
+
+	if _, err := h.movieUsecase.DeleteMovie(c.Request.Context(), id); err != nil {
+		writeProblem(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func parseMovieID(c *gin.Context) (int64, error) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return 0, usecase.NewInvalidArgumentError("invalid id", usecase.FieldViolation{Field: "id", Description: "must be an integer"})
+	}
+	return id, nil
+}
+
+func toMovieDomain(id int64, req *dto.MovieRequest) (*domain.Movie, error) {
+	releaseDate, err := usecase.ParseReleaseDate(req.ReleaseDate)
+	if err != nil {
+		return nil, err
+	}
+	return &domain.Movie{
+		ID:          id,
+		Title:       req.Title,
+		Description: req.Description,
+		ReleaseDate: releaseDate,
+	}, nil
+}
+
+func toMovieResponse(m *domain.Movie) dto.MovieResponse {
+	return dto.MovieResponse{
+		ID:          m.ID,
+		Title:       m.Title,
+		Description: m.Description,
+		ReleaseDate: m.ReleaseDate.String(),
+	}
+}
+
+// movieQueryParams maps usecase field names to the query parameters of
+// GET /movies.
+var movieQueryParams = map[string]string{
+	"titleContains": "title_contains",
+	"releasedFrom":  "released_from",
+	"releasedTo":    "released_to",
+	"pageSize":      "limit",
+}
+
+var restMovieSortFields = map[string]domain.MovieSortField{
+	"id":           domain.MovieSortByID,
+	"title":        domain.MovieSortByTitle,
+	"release_date": domain.MovieSortByReleaseDate,
+}
+
+// parseMovieListQuery reads the title_contains, released_from, released_to,
+// sort and order query parameters, reporting every bad one at once.
+func parseMovieListQuery(c *gin.Context) (domain.MovieFilter, domain.MovieSort, error) {
+	var (
+		filter     domain.MovieFilter
+		sort       domain.MovieSort
+		violations []usecase.FieldViolation
+	)
+
+	filter.TitleContains = c.Query("title_contains")
+	for _, p := range []struct {
+		param string
+		date  *domain.Date
+	}{
+		{"released_from", &filter.ReleasedFrom},
+		{"released_to", &filter.ReleasedTo},
+	} {
+		if err := p.date.UnmarshalText([]byte(c.Query(p.param))); err != nil {
+			violations = append(violations, usecase.FieldViolation{Field: p.param, Description: "must be a date in YYYY-MM-DD format"})
+		}
+	}
+
+	if sortStr := c.Query("sort"); sortStr != "" {
+		field, ok := restMovieSortFields[sortStr]
+		if !ok {
+			violations = append(violations, usecase.FieldViolation{Field: "sort", Description: "must be one of id, title or release_date"})
+		}
+		sort.Field = field
+	}
+	switch c.Query("order") {
+	case "", "asc":
+	case "desc":
+		sort.Descending = true
+	default:
+		violations = append(violations, usecase.FieldViolation{Field: "order", Description: "must be asc or desc"})
+	}
+
+	if len(violations) > 0 {
+		return domain.MovieFilter{}, domain.MovieSort{}, usecase.NewInvalidArgumentError("invalid query parameters", violations...)
+	}
+	return filter, sort, nil
 }
@@ -0,0 +1,175 @@
+package httphandler
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sorrawichYooboon/go-protocol-api-style/internal/domain"
+	mockRepo "github.com/sorrawichYooboon/go-protocol-api-style/internal/mock"
+	"github.com/sorrawichYooboon/go-protocol-api-style/internal/usecase"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func newMovieTestRouter(movieRepo *mockRepo.MovieRepository, movieEventBus *mockRepo.MovieEventBus) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	h := NewMovieHandler(usecase.NewMovieUsecase(movieRepo, nil, movieEventBus))
+
+	r := gin.New()
+	r.Use(func(c *gin.Context) {
+		principal := &domain.Principal{Subject: "apikey:1", Name: "test", Scopes: []string{usecase.ScopeMoviesRead, usecase.ScopeMoviesWrite}}
+		c.Request = c.Request.WithContext(usecase.WithPrincipal(context.Background(), principal))
+		c.Next()
+	})
+	r.POST("/movies", h.CreateMovie)
+	r.PUT("/movies/:id", h.UpdateMovie)
+	r.DELETE("/movies/:id", h.DeleteMovie)
+	return r
+}
+
+func TestMovieHandler_WriteOperations(t *testing.T) {
+	mockMovieRepo := mockRepo.NewMovieRepository(t)
+	mockMovieEventBus := mockRepo.NewMovieEventBus(t)
+
+	clearAllMock := func() {
+		mockMovieRepo.ClearAll()
+		mockMovieEventBus.ClearAll()
+	}
+
+	dune := &domain.Movie{ID: 4, Title: "Dune", ReleaseDate: domain.Date{Year: 2021, Month: time.October, Day: 22}}
+	duneBody := `{"title":"Dune","release_date":"2021-10-22"}`
+	duneResponse := `{"id":4,"title":"Dune","description":"","release_date":"2021-10-22"}`
+
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		body   string
+
+		wantServiceOrRepoCallWithAndResponse func()
+		wantStatus                           int
+		wantBody                             string
+	}{
+		{
+			name:       "create with invalid release date",
+			method:     http.MethodPost,
+			path:       "/movies",
+			body:       `{"title":"Dune","release_date":"22/10/2021"}`,
+			wantStatus: http.StatusBadRequest,
+			wantBody:   `"name":"release_date"`,
+		},
+		{
+			name:       "create with empty title",
+			method:     http.MethodPost,
+			path:       "/movies",
+			body:       `{"title":" ","release_date":"2021-10-22"}`,
+			wantStatus: http.StatusBadRequest,
+			wantBody:   `"name":"title"`,
+		},
+		{
+			name:   "create",
+			method: http.MethodPost,
+			path:   "/movies",
+			body:   duneBody,
+			wantServiceOrRepoCallWithAndResponse: func() {
+				mockMovieRepo.On("Create", mock.Anything, &domain.Movie{Title: "Dune", ReleaseDate: dune.ReleaseDate}).Return(dune, nil)
+				mockMovieEventBus.On("Publish", mock.Anything).Return()
+			},
+			wantStatus: http.StatusCreated,
+			wantBody:   duneResponse,
+		},
+		{
+			name:       "update with invalid id",
+			method:     http.MethodPut,
+			path:       "/movies/abc",
+			body:       duneBody,
+			wantStatus: http.StatusBadRequest,
+			wantBody:   `"name":"id"`,
+		},
+		{
+			name:       "update with malformed body",
+			method:     http.MethodPut,
+			path:       "/movies/4",
+			body:       `{"title":`,
+			wantStatus: http.StatusBadRequest,
+			wantBody:   "invalid request body",
+		},
+		{
+			name:   "update missing movie",
+			method: http.MethodPut,
+			path:   "/movies/7",
+			body:   duneBody,
+			wantServiceOrRepoCallWithAndResponse: func() {
+				mockMovieRepo.On("Update", mock.Anything, mock.Anything).Return(nil, nil)
+			},
+			wantStatus: http.StatusNotFound,
+			wantBody:   "movie 7 not found",
+		},
+		{
+			name:   "update",
+			method: http.MethodPut,
+			path:   "/movies/4",
+			body:   duneBody,
+			wantServiceOrRepoCallWithAndResponse: func() {
+				mockMovieRepo.On("Update", mock.Anything, dune).Return(dune, nil)
+				mockMovieEventBus.On("Publish", mock.Anything).Return()
+			},
+			wantStatus: http.StatusOK,
+			wantBody:   duneResponse,
+		},
+		{
+			name:       "delete with invalid id",
+			method:     http.MethodDelete,
+			path:       "/movies/abc",
+			wantStatus: http.StatusBadRequest,
+			wantBody:   `"name":"id"`,
+		},
+		{
+			name:   "delete missing movie",
+			method: http.MethodDelete,
+			path:   "/movies/7",
+			wantServiceOrRepoCallWithAndResponse: func() {
+				mockMovieRepo.On("Delete", mock.Anything, int64(7)).Return(nil, nil)
+			},
+			wantStatus: http.StatusNotFound,
+			wantBody:   "movie 7 not found",
+		},
+		{
+			name:   "delete",
+			method: http.MethodDelete,
+			path:   "/movies/4",
+			wantServiceOrRepoCallWithAndResponse: func() {
+				mockMovieRepo.On("Delete", mock.Anything, int64(4)).Return(dune, nil)
+				mockMovieEventBus.On("Publish", mock.Anything).Return()
+			},
+			wantStatus: http.StatusNoContent,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			defer clearAllMock()
+
+			if test.wantServiceOrRepoCallWithAndResponse != nil {
+				test.wantServiceOrRepoCallWithAndResponse()
+			}
+
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(test.method, test.path, strings.NewReader(test.body))
+			req.Header.Set("Content-Type", "application/json")
+			newMovieTestRouter(mockMovieRepo, mockMovieEventBus).ServeHTTP(w, req)
+
+			assert.Equal(t, test.wantStatus, w.Code)
+			if test.wantStatus < http.StatusBadRequest && test.wantBody != "" {
+				assert.JSONEq(t, test.wantBody, w.Body.String())
+			} else {
+				assert.Contains(t, w.Body.String(), test.wantBody)
+			}
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package httphandler
+
+import (
+	"context"
+	"errors"
+	"math"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sorrawichYooboon/go-protocol-api-style/internal/usecase"
+	"github.com/sorrawichYooboon/go-protocol-api-style/logger"
+)
+
+const problemContentType = "application/problem+json"
+
+// Problem is an RFC 7807 problem details document.
+type Problem struct {
+	Type          string         `json:"type"`
+	Title         string         `json:"title"`
+	Status        int            `json:"status"`
+	Detail        string         `json:"detail,omitempty"`
+	Instance      string         `json:"instance,omitempty"`
+	Code          string         `json:"code"`
+	InvalidParams []InvalidParam `json:"invalid-params,omitempty"`
+}
+
+type InvalidParam struct {
+	Name   string `json:"name"`
+	Reason string `json:"reason"`
+}
+
+var httpStatuses = map[usecase.ErrorCode]int{
+	usecase.ErrCodeNotFound:          http.StatusNotFound,
+	usecase.ErrCodeInvalidArgument:   http.StatusBadRequest,
+	usecase.ErrCodeConflict:          http.StatusConflict,
+	usecase.ErrCodeUnauthenticated:   http.StatusUnauthorized,
+	usecase.ErrCodePermissionDenied:  http.StatusForbidden,
+	usecase.ErrCodeUnavailable:       http.StatusServiceUnavailable,
+	usecase.ErrCodeResourceExhausted: http.StatusTooManyRequests,
+	usecase.ErrCodeInternal:          http.StatusInternalServerError,
+}
+
+// AbortWithProblem lets middleware answer with the same problem documents
+// as the handlers.
+func AbortWithProblem(c *gin.Context, err error) {
+	writeProblem(c, err)
+}
+
+func writeProblem(c *gin.Context, err error) {
+	if errors.Is(err, context.Canceled) && c.Request.Context().Err() != nil {
+		// The client went away; nobody is left to read a response.
+		c.Abort()
+		return
+	}
+
+	ucErr := usecase.AsError(err)
+	if ucErr.Code == usecase.ErrCodeInternal {
+		logger.LogError(c.FullPath(), err)
+	}
+
+	status := httpStatuses[ucErr.Code]
+	problem := Problem{
+		Type:     "about:blank",
+		Title:    http.StatusText(status),
+		Status:   status,
+		Detail:   ucErr.Message,
+		Instance: c.Request.URL.Path,
+		Code:     string(ucErr.Code),
+	}
+	for _, v := range ucErr.Violations {
+		problem.InvalidParams = append(problem.InvalidParams, InvalidParam{Name: v.Field, Reason: v.Description})
+	}
+
+	if ucErr.Code == usecase.ErrCodeUnauthenticated {
+		c.Header("WWW-Authenticate", "Bearer, ApiKey")
+	}
+	if ucErr.RetryAfter > 0 {
+		c.Header("Retry-After", strconv.Itoa(int(math.Ceil(ucErr.RetryAfter.Seconds()))))
+	}
+	c.Header("Content-Type", problemContentType)
+	c.AbortWithStatusJSON(status, problem)
+}
@@ -0,0 +1,28 @@
+package httphandler
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sorrawichYooboon/go-protocol-api-style/internal/usecase"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteProblem_RenamedViolations(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodPost, "/movies", nil)
+
+	writeProblem(c, usecase.RenameViolations(usecase.ErrInvalidReleaseDate, movieBodyFields))
+
+	var problem Problem
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &problem))
+	assert.Equal(t, http.StatusBadRequest, problem.Status)
+	assert.Equal(t, []InvalidParam{{Name: "release_date", Reason: "must be a date in YYYY-MM-DD format"}}, problem.InvalidParams)
+	assert.Equal(t, "releaseDate", usecase.ErrInvalidReleaseDate.Violations[0].Field, "the shared error must not be modified")
+}
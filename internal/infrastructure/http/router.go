@@ -5,10 +5,18 @@ import (
 	httphandler "github.com/sorrawichYooboon/go-protocol-api-style/internal/infrastructure/http/handler"
 )
 
-func SetupRoutes(router *gin.Engine, movieHandler httphandler.MovieHandler) {
-	movies := router.Group("/movies")
+func SetupRoutes(router *gin.Engine, movieHandler httphandler.MovieHandler, middleware ...gin.HandlerFunc) {
+	movies := router.Group("/movies", middleware...)
 	{
 		movies.GET("", movieHandler.GetMovies)
 		movies.GET("/:id", movieHandler.GetMovieByID)
+		movies.POST("", movieHandler.CreateMovie)
+		movies.PUT("/:id", movieHandler.UpdateMovie)
+		movies.DELETE("/:id", movieHandler.DeleteMovie)
 	}
 }
+
+func SetupHealthRoutes(router *gin.Engine, healthHandler httphandler.HealthHandler) {
+	router.GET("/healthz", healthHandler.Liveness)
+	router.GET("/readyz", healthHandler.Readiness)
+}
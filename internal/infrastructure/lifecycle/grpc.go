@@ -0,0 +1,51 @@
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"net"
+
+	"google.golang.org/grpc"
+)
+
+type GRPCServer struct {
+	addr   string
+	server *grpc.Server
+}
+
+func NewGRPCServer(addr string, server *grpc.Server) *GRPCServer {
+	return &GRPCServer{addr: addr, server: server}
+}
+
+func (s *GRPCServer) Name() string {
+	return "grpc server " + s.addr
+}
+
+func (s *GRPCServer) Start() error {
+	lis, err := net.Listen("tcp", s.addr)
+	if err != nil {
+		return err
+	}
+	if err := s.server.Serve(lis); err != nil && !errors.Is(err, grpc.ErrServerStopped) {
+		return err
+	}
+	return nil
+}
+
+// Shutdown waits for in-flight RPCs, including open streams, and force
+// closes them once ctx expires.
+func (s *GRPCServer) Shutdown(ctx context.Context) error {
+	done := make(chan struct{})
+	go func() {
+		s.server.GracefulStop()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		s.server.Stop()
+		return ctx.Err()
+	}
+}
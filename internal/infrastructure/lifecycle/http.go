@@ -0,0 +1,36 @@
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"net/http"
+)
+
+type HTTPServer struct {
+	server *http.Server
+}
+
+// NewHTTPServer runs each onShutdown function when Shutdown starts, to end
+// long-lived connections such as WebSockets that Shutdown does not track.
+func NewHTTPServer(addr string, handler http.Handler, onShutdown ...func()) *HTTPServer {
+	server := &http.Server{Addr: addr, Handler: handler}
+	for _, f := range onShutdown {
+		server.RegisterOnShutdown(f)
+	}
+	return &HTTPServer{server: server}
+}
+
+func (s *HTTPServer) Name() string {
+	return "http server " + s.server.Addr
+}
+
+func (s *HTTPServer) Start() error {
+	if err := s.server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
+
+func (s *HTTPServer) Shutdown(ctx context.Context) error {
+	return s.server.Shutdown(ctx)
+}
@@ -0,0 +1,23 @@
+package lifecycle
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHTTPServer_ShutdownRunsHooks(t *testing.T) {
+	called := make(chan struct{})
+	s := NewHTTPServer("127.0.0.1:0", http.NotFoundHandler(), func() { close(called) })
+
+	require.NoError(t, s.Shutdown(context.Background()))
+	select {
+	case <-called:
+	case <-time.After(time.Second):
+		assert.Fail(t, "onShutdown was not called")
+	}
+}
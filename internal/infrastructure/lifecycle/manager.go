@@ -0,0 +1,107 @@
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/sorrawichYooboon/go-protocol-api-style/logger"
+)
+
+// Component is a long running server managed by Manager. Start blocks until
+// the component stops and must return nil after a graceful Shutdown.
+type Component interface {
+	Name() string
+	Start() error
+	Shutdown(ctx context.Context) error
+}
+
+type closer struct {
+	name string
+	fn   func() error
+}
+
+type Manager struct {
+	components      []Component
+	closers         []closer
+	shutdownTimeout time.Duration
+}
+
+func NewManager(shutdownTimeout time.Duration) *Manager {
+	return &Manager{shutdownTimeout: shutdownTimeout}
+}
+
+func (m *Manager) Add(c Component) {
+	m.components = append(m.components, c)
+}
+
+// OnClose registers a resource to release once every component has drained,
+// e.g. the database pool.
+func (m *Manager) OnClose(name string, fn func() error) {
+	m.closers = append(m.closers, closer{name: name, fn: fn})
+}
+
+// Run starts every component and blocks until ctx is cancelled (usually by a
+// signal) or a component fails. It then drains all components within the
+// shutdown timeout and returns every error encountered along the way.
+func (m *Manager) Run(ctx context.Context) error {
+	errCh := make(chan error, len(m.components))
+	for _, c := range m.components {
+		go func(c Component) {
+			logger.LogInfo("lifecycle.Run", "starting "+c.Name())
+			if err := c.Start(); err != nil {
+				errCh <- fmt.Errorf("%s: %w", c.Name(), err)
+				return
+			}
+			errCh <- nil
+		}(c)
+	}
+
+	var errs []error
+	running := len(m.components)
+	select {
+	case <-ctx.Done():
+		logger.LogInfo("lifecycle.Run", "shutdown requested")
+	case err := <-errCh:
+		running--
+		if err != nil {
+			errs = append(errs, err)
+		} else {
+			errs = append(errs, errors.New("a component stopped unexpectedly"))
+		}
+	}
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), m.shutdownTimeout)
+	defer cancel()
+
+	shutdownErrCh := make(chan error, len(m.components))
+	for _, c := range m.components {
+		go func(c Component) {
+			if err := c.Shutdown(shutdownCtx); err != nil {
+				shutdownErrCh <- fmt.Errorf("%s shutdown: %w", c.Name(), err)
+				return
+			}
+			shutdownErrCh <- nil
+		}(c)
+	}
+	for range m.components {
+		if err := <-shutdownErrCh; err != nil {
+			errs = append(errs, err)
+		}
+	}
+	for ; running > 0; running-- {
+		if err := <-errCh; err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	for _, cl := range m.closers {
+		if err := cl.fn(); err != nil {
+			errs = append(errs, fmt.Errorf("%s close: %w", cl.name, err))
+		}
+	}
+
+	logger.LogInfo("lifecycle.Run", "shutdown complete")
+	return errors.Join(errs...)
+}
@@ -0,0 +1,92 @@
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeComponent struct {
+	startErr error
+	stopped  chan struct{}
+	shutdown bool
+}
+
+func newFakeComponent(startErr error) *fakeComponent {
+	return &fakeComponent{startErr: startErr, stopped: make(chan struct{})}
+}
+
+func (f *fakeComponent) Name() string { return "fake" }
+
+func (f *fakeComponent) Start() error {
+	if f.startErr != nil {
+		return f.startErr
+	}
+	<-f.stopped
+	return nil
+}
+
+func (f *fakeComponent) Shutdown(ctx context.Context) error {
+	f.shutdown = true
+	select {
+	case <-f.stopped:
+	default:
+		close(f.stopped)
+	}
+	return nil
+}
+
+func Test_manager_run(t *testing.T) {
+	tests := []struct {
+		name      string
+		startErr  error
+		cancelCtx bool
+		wantErr   bool
+	}{
+		{
+			name:      "Test should drain components and close resources when context is canceled",
+			cancelCtx: true,
+			wantErr:   false,
+		},
+		{
+			name:     "Test should shut down remaining components and return error when a component fails",
+			startErr: errors.New("address already in use"),
+			wantErr:  true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			healthy := newFakeComponent(nil)
+			failing := newFakeComponent(test.startErr)
+			closed := false
+
+			manager := NewManager(time.Second)
+			manager.Add(healthy)
+			manager.Add(failing)
+			manager.OnClose("resource", func() error {
+				closed = true
+				return nil
+			})
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			if test.cancelCtx {
+				cancel()
+			}
+
+			err := manager.Run(ctx)
+
+			if test.wantErr {
+				assert.ErrorIs(t, err, test.startErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.True(t, healthy.shutdown)
+			assert.True(t, closed)
+		})
+	}
+}
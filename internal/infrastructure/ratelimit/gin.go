@@ -0,0 +1,20 @@
+package ratelimit
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/sorrawichYooboon/go-protocol-api-style/internal/usecase"
+)
+
+// Middleware rejects requests over the client's budget through reject, so
+// each protocol can answer in its own error format. Mounted before the auth
+// middleware it limits by client IP; mounted after, by principal.
+func Middleware(l *Limiter, reject func(*gin.Context, error)) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		key := ClientKey(c.Request.Context(), c.ClientIP())
+		if ok, retryAfter := l.Allow(key); !ok {
+			reject(c, usecase.NewResourceExhaustedError(retryAfter))
+			return
+		}
+		c.Next()
+	}
+}
@@ -0,0 +1,84 @@
+package ratelimit
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sorrawichYooboon/go-protocol-api-style/internal/domain"
+	"github.com/sorrawichYooboon/go-protocol-api-style/internal/usecase"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func rejectWithStatus(c *gin.Context, err error) {
+	c.AbortWithStatus(http.StatusTooManyRequests)
+}
+
+func serve(r *gin.Engine, apiKey string) int {
+	return serveFrom(r, apiKey, "")
+}
+
+func serveFrom(r *gin.Engine, apiKey, forwardedFor string) int {
+	req := httptest.NewRequest(http.MethodGet, "/movies", nil)
+	req.RemoteAddr = "203.0.113.7:4711"
+	req.Header.Set("X-API-Key", apiKey)
+	if forwardedFor != "" {
+		req.Header.Set("X-Forwarded-For", forwardedFor)
+	}
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	return w.Code
+}
+
+func TestMiddleware_RotatingBogusKeysAreThrottled(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	l, _ := newTestLimiter(1, 2)
+	r := gin.New()
+	r.GET("/movies", Middleware(l, rejectWithStatus), func(c *gin.Context) {
+		// Stands in for the auth middleware, which rejects every made-up key.
+		c.AbortWithStatus(http.StatusUnauthorized)
+	})
+
+	var codes []int
+	for i := 0; i < 4; i++ {
+		codes = append(codes, serve(r, fmt.Sprintf("bogus-%d", i)))
+	}
+	assert.Equal(t, []int{http.StatusUnauthorized, http.StatusUnauthorized, http.StatusTooManyRequests, http.StatusTooManyRequests}, codes)
+}
+
+func TestMiddleware_KeysByPrincipalAfterAuth(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	l, _ := newTestLimiter(1, 1)
+	r := gin.New()
+	r.GET("/movies", func(c *gin.Context) {
+		// Stands in for the auth middleware; the key names the principal.
+		principal := &domain.Principal{Subject: "apikey:" + c.GetHeader("X-API-Key")}
+		c.Request = c.Request.WithContext(usecase.WithPrincipal(c.Request.Context(), principal))
+	}, Middleware(l, rejectWithStatus), func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+
+	assert.Equal(t, http.StatusOK, serve(r, "1"))
+	assert.Equal(t, http.StatusTooManyRequests, serve(r, "1"))
+	assert.Equal(t, http.StatusOK, serve(r, "2"), "principals behind one IP have separate budgets")
+}
+
+func TestMiddleware_SpoofedForwardedForIsThrottled(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	l, _ := newTestLimiter(1, 2)
+	r := gin.New()
+	// As in main with TRUSTED_PROXIES unset.
+	require.NoError(t, r.SetTrustedProxies(nil))
+	r.GET("/movies", Middleware(l, rejectWithStatus), func(c *gin.Context) {
+		c.AbortWithStatus(http.StatusUnauthorized)
+	})
+
+	var codes []int
+	for i := 0; i < 4; i++ {
+		codes = append(codes, serveFrom(r, "bogus", fmt.Sprintf("198.51.100.%d", i)))
+	}
+	assert.Equal(t, []int{http.StatusUnauthorized, http.StatusUnauthorized, http.StatusTooManyRequests, http.StatusTooManyRequests}, codes)
+}
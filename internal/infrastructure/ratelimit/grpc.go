@@ -0,0 +1,53 @@
+package ratelimit
+
+import (
+	"context"
+	"net"
+	"strings"
+
+	"github.com/sorrawichYooboon/go-protocol-api-style/internal/usecase"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
+)
+
+// Health probes are never limited, like /healthz and /readyz over HTTP.
+const healthServicePrefix = "/grpc.health.v1.Health/"
+
+// UnaryInterceptor returns a usecase error when the client is over budget; it
+// must run inside the error interceptor so the error becomes RESOURCE_EXHAUSTED.
+// Chained before the auth interceptor it limits by peer IP; chained after, by
+// principal.
+func UnaryInterceptor(l *Limiter) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if strings.HasPrefix(info.FullMethod, healthServicePrefix) {
+			return handler(ctx, req)
+		}
+		if ok, retryAfter := l.Allow(grpcClientKey(ctx)); !ok {
+			return nil, usecase.NewResourceExhaustedError(retryAfter)
+		}
+		return handler(ctx, req)
+	}
+}
+
+func StreamInterceptor(l *Limiter) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if strings.HasPrefix(info.FullMethod, healthServicePrefix) {
+			return handler(srv, ss)
+		}
+		if ok, retryAfter := l.Allow(grpcClientKey(ss.Context())); !ok {
+			return usecase.NewResourceExhaustedError(retryAfter)
+		}
+		return handler(srv, ss)
+	}
+}
+
+func grpcClientKey(ctx context.Context) string {
+	var ip string
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		ip = p.Addr.String()
+		if host, _, err := net.SplitHostPort(ip); err == nil {
+			ip = host
+		}
+	}
+	return ClientKey(ctx, ip)
+}
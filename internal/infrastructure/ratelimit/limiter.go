@@ -0,0 +1,95 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/sorrawichYooboon/go-protocol-api-style/internal/usecase"
+	"golang.org/x/time/rate"
+)
+
+const idleTTL = 10 * time.Minute
+
+type bucket struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+// Limiter keeps one token bucket per client. A single Limiter is shared by
+// every transport so a client has the same budget whichever protocol it uses.
+type Limiter struct {
+	mu        sync.Mutex
+	limit     rate.Limit
+	burst     int
+	buckets   map[string]*bucket
+	lastSweep time.Time
+	now       func() time.Time
+}
+
+// NewLimiter refills each client's bucket at rps tokens per second up to
+// burst. A non-positive rps disables limiting.
+func NewLimiter(rps float64, burst int) *Limiter {
+	return &Limiter{
+		limit:   rate.Limit(rps),
+		burst:   burst,
+		buckets: make(map[string]*bucket),
+		now:     time.Now,
+	}
+}
+
+// Allow takes a token for key. When none is left it returns false and how
+// long the client should wait before the next token is available.
+func (l *Limiter) Allow(key string) (bool, time.Duration) {
+	if l.limit <= 0 {
+		return true, 0
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	l.sweep(now)
+
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &bucket{limiter: rate.NewLimiter(l.limit, l.burst)}
+		l.buckets[key] = b
+	}
+	b.lastSeen = now
+
+	r := b.limiter.ReserveN(now, 1)
+	if !r.OK() {
+		return false, time.Second
+	}
+	if delay := r.DelayFrom(now); delay > 0 {
+		r.CancelAt(now)
+		return false, delay
+	}
+	return true, 0
+}
+
+// sweep drops buckets of clients that have been idle long enough to be
+// full again, so the map does not grow with every address ever seen.
+func (l *Limiter) sweep(now time.Time) {
+	if now.Sub(l.lastSweep) < idleTTL {
+		return
+	}
+	l.lastSweep = now
+	for key, b := range l.buckets {
+		if now.Sub(b.lastSeen) >= idleTTL {
+			delete(l.buckets, key)
+		}
+	}
+}
+
+// ClientKey identifies the caller by the principal authenticated for ctx
+// and falls back to the client IP before authentication has run. Credentials
+// a client merely sends are never used, so rotating made-up API keys does not
+// buy fresh buckets and nobody can spend another client's budget.
+func ClientKey(ctx context.Context, ip string) string {
+	if principal, ok := usecase.PrincipalFromContext(ctx); ok {
+		return "principal:" + principal.Subject
+	}
+	return "ip:" + ip
+}
@@ -0,0 +1,70 @@
+package ratelimit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestLimiter(rps float64, burst int) (*Limiter, *time.Time) {
+	now := time.Unix(0, 0)
+	l := NewLimiter(rps, burst)
+	l.now = func() time.Time { return now }
+	return l, &now
+}
+
+func TestLimiter_Allow(t *testing.T) {
+	l, now := newTestLimiter(1, 2)
+
+	ok, _ := l.Allow("ip:1.1.1.1")
+	assert.True(t, ok)
+	ok, _ = l.Allow("ip:1.1.1.1")
+	assert.True(t, ok)
+
+	ok, retryAfter := l.Allow("ip:1.1.1.1")
+	assert.False(t, ok)
+	assert.Equal(t, time.Second, retryAfter)
+
+	ok, _ = l.Allow("ip:2.2.2.2")
+	assert.True(t, ok, "clients have separate budgets")
+
+	*now = now.Add(time.Second)
+	ok, _ = l.Allow("ip:1.1.1.1")
+	assert.True(t, ok, "bucket refills over time")
+}
+
+func TestLimiter_RejectedRequestsDoNotConsumeTokens(t *testing.T) {
+	l, now := newTestLimiter(1, 1)
+
+	ok, _ := l.Allow("key:a")
+	assert.True(t, ok)
+	for i := 0; i < 5; i++ {
+		ok, _ = l.Allow("key:a")
+		assert.False(t, ok)
+	}
+
+	*now = now.Add(time.Second)
+	ok, _ = l.Allow("key:a")
+	assert.True(t, ok)
+}
+
+func TestLimiter_Disabled(t *testing.T) {
+	l, _ := newTestLimiter(0, 1)
+
+	for i := 0; i < 10; i++ {
+		ok, _ := l.Allow("ip:1.1.1.1")
+		assert.True(t, ok)
+	}
+}
+
+func TestLimiter_SweepsIdleClients(t *testing.T) {
+	l, now := newTestLimiter(1, 1)
+
+	l.Allow("ip:1.1.1.1")
+	*now = now.Add(idleTTL)
+	l.Allow("ip:2.2.2.2")
+
+	assert.Len(t, l.buckets, 1)
+	assert.Contains(t, l.buckets, "ip:2.2.2.2")
+}
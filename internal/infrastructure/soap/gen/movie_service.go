@@ -17,8 +17,66 @@ func NewMovieServicePortType(cli *soap.Client) MovieServicePortType {
 // MovieServicePortType was auto-generated from WSDL
 // and defines interface for the remote service. Useful for testing.
 type MovieServicePortType interface {
+	// CreateMovie was auto-generated from WSDL.
+	CreateMovie(CreateMovieRequest *CreateMovieRequest) (*CreateMovieResponse, error)
+
+	// DeleteMovie was auto-generated from WSDL.
+	DeleteMovie(DeleteMovieRequest *DeleteMovieRequest) (*DeleteMovieResponse, error)
+
 	// GetMovie was auto-generated from WSDL.
 	GetMovie(GetMovieRequest *GetMovieRequest) (*GetMovieResponse, error)
+
+	// GetPoster was auto-generated from WSDL.
+	GetPoster(GetPosterRequest *GetPosterRequest) (*GetPosterResponse, error)
+
+	// ListMovies was auto-generated from WSDL.
+	ListMovies(ListMoviesRequest *ListMoviesRequest) (*ListMoviesResponse, error)
+
+	// SearchMovies was auto-generated from WSDL.
+	SearchMovies(SearchMoviesRequest *SearchMoviesRequest) (*SearchMoviesResponse, error)
+
+	// UpdateMovie was auto-generated from WSDL.
+	UpdateMovie(UpdateMovieRequest *UpdateMovieRequest) (*UpdateMovieResponse, error)
+
+	// UploadPoster was auto-generated from WSDL.
+	UploadPoster(UploadPosterRequest *UploadPosterRequest) (*UploadPosterResponse, error)
+}
+
+// Date in WSDL format.
+type Date string
+
+// CreateMovieRequest was auto-generated from WSDL.
+type CreateMovieRequest struct {
+	Title       *string `xml:"title,omitempty" json:"title,omitempty" yaml:"title,omitempty"`
+	Description *string `xml:"description,omitempty" json:"description,omitempty" yaml:"description,omitempty"`
+	ReleaseDate *Date   `xml:"releaseDate,omitempty" json:"releaseDate,omitempty" yaml:"releaseDate,omitempty"`
+}
+
+// CreateMovieResponse was auto-generated from WSDL.
+type CreateMovieResponse struct {
+	Id          *int64  `xml:"id,omitempty" json:"id,omitempty" yaml:"id,omitempty"`
+	Title       *string `xml:"title,omitempty" json:"title,omitempty" yaml:"title,omitempty"`
+	Description *string `xml:"description,omitempty" json:"description,omitempty" yaml:"description,omitempty"`
+	ReleaseDate *Date   `xml:"releaseDate,omitempty" json:"releaseDate,omitempty" yaml:"releaseDate,omitempty"`
+}
+
+// DeleteMovieRequest was auto-generated from WSDL.
+type DeleteMovieRequest struct {
+	Id *int64 `xml:"id,omitempty" json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+// DeleteMovieResponse was auto-generated from WSDL.
+type DeleteMovieResponse struct {
+	Id          *int64  `xml:"id,omitempty" json:"id,omitempty" yaml:"id,omitempty"`
+	Title       *string `xml:"title,omitempty" json:"title,omitempty" yaml:"title,omitempty"`
+	Description *string `xml:"description,omitempty" json:"description,omitempty" yaml:"description,omitempty"`
+	ReleaseDate *Date   `xml:"releaseDate,omitempty" json:"releaseDate,omitempty" yaml:"releaseDate,omitempty"`
+}
+
+// FieldViolation was auto-generated from WSDL.
+type FieldViolation struct {
+	Field       *string `xml:"field,omitempty" json:"field,omitempty" yaml:"field,omitempty"`
+	Description *string `xml:"description,omitempty" json:"description,omitempty" yaml:"description,omitempty"`
 }
 
 // GetMovieRequest was auto-generated from WSDL.
@@ -31,7 +89,118 @@ type GetMovieResponse struct {
 	Id          *int64  `xml:"id,omitempty" json:"id,omitempty" yaml:"id,omitempty"`
 	Title       *string `xml:"title,omitempty" json:"title,omitempty" yaml:"title,omitempty"`
 	Description *string `xml:"description,omitempty" json:"description,omitempty" yaml:"description,omitempty"`
-	ReleaseDate *string `xml:"releaseDate,omitempty" json:"releaseDate,omitempty" yaml:"releaseDate,omitempty"`
+	ReleaseDate *Date   `xml:"releaseDate,omitempty" json:"releaseDate,omitempty" yaml:"releaseDate,omitempty"`
+}
+
+// GetPosterRequest was auto-generated from WSDL.
+type GetPosterRequest struct {
+	MovieId *int64 `xml:"movieId,omitempty" json:"movieId,omitempty" yaml:"movieId,omitempty"`
+}
+
+// GetPosterResponse was auto-generated from WSDL.
+type GetPosterResponse struct {
+	MovieId     *int64  `xml:"movieId,omitempty" json:"movieId,omitempty" yaml:"movieId,omitempty"`
+	ContentType *string `xml:"contentType,omitempty" json:"contentType,omitempty" yaml:"contentType,omitempty"`
+	Size        *int64  `xml:"size,omitempty" json:"size,omitempty" yaml:"size,omitempty"`
+	Image       *[]byte `xml:"image,omitempty" json:"image,omitempty" yaml:"image,omitempty"`
+}
+
+// ListMoviesRequest was auto-generated from WSDL.
+type ListMoviesRequest struct {
+	PageSize      *int    `xml:"pageSize,omitempty" json:"pageSize,omitempty" yaml:"pageSize,omitempty"`
+	PageToken     *string `xml:"pageToken,omitempty" json:"pageToken,omitempty" yaml:"pageToken,omitempty"`
+	TitleContains *string `xml:"titleContains,omitempty" json:"titleContains,omitempty" yaml:"titleContains,omitempty"`
+	ReleasedFrom  *Date   `xml:"releasedFrom,omitempty" json:"releasedFrom,omitempty" yaml:"releasedFrom,omitempty"`
+	ReleasedTo    *Date   `xml:"releasedTo,omitempty" json:"releasedTo,omitempty" yaml:"releasedTo,omitempty"`
+	SortBy        *string `xml:"sortBy,omitempty" json:"sortBy,omitempty" yaml:"sortBy,omitempty"`
+	Descending    *bool   `xml:"descending,omitempty" json:"descending,omitempty" yaml:"descending,omitempty"`
+}
+
+// ListMoviesResponse was auto-generated from WSDL.
+type ListMoviesResponse struct {
+	Movie         []*Movie `xml:"movie,omitempty" json:"movie,omitempty" yaml:"movie,omitempty"`
+	NextPageToken *string  `xml:"nextPageToken,omitempty" json:"nextPageToken,omitempty" yaml:"nextPageToken,omitempty"`
+}
+
+// Movie was auto-generated from WSDL.
+type Movie struct {
+	Id          *int64  `xml:"id,omitempty" json:"id,omitempty" yaml:"id,omitempty"`
+	Title       *string `xml:"title,omitempty" json:"title,omitempty" yaml:"title,omitempty"`
+	Description *string `xml:"description,omitempty" json:"description,omitempty" yaml:"description,omitempty"`
+	ReleaseDate *Date   `xml:"releaseDate,omitempty" json:"releaseDate,omitempty" yaml:"releaseDate,omitempty"`
+}
+
+// MovieFault was auto-generated from WSDL.
+type MovieFault struct {
+	Code      *string           `xml:"code,omitempty" json:"code,omitempty" yaml:"code,omitempty"`
+	Message   *string           `xml:"message,omitempty" json:"message,omitempty" yaml:"message,omitempty"`
+	Violation []*FieldViolation `xml:"violation,omitempty" json:"violation,omitempty" yaml:"violation,omitempty"`
+}
+
+// SearchMoviesRequest was auto-generated from WSDL.
+type SearchMoviesRequest struct {
+	Query     *string `xml:"query,omitempty" json:"query,omitempty" yaml:"query,omitempty"`
+	PageSize  *int    `xml:"pageSize,omitempty" json:"pageSize,omitempty" yaml:"pageSize,omitempty"`
+	PageToken *string `xml:"pageToken,omitempty" json:"pageToken,omitempty" yaml:"pageToken,omitempty"`
+}
+
+// SearchMoviesResponse was auto-generated from WSDL.
+type SearchMoviesResponse struct {
+	Movie         []*Movie `xml:"movie,omitempty" json:"movie,omitempty" yaml:"movie,omitempty"`
+	NextPageToken *string  `xml:"nextPageToken,omitempty" json:"nextPageToken,omitempty" yaml:"nextPageToken,omitempty"`
+}
+
+// UpdateMovieRequest was auto-generated from WSDL.
+type UpdateMovieRequest struct {
+	Id          *int64  `xml:"id,omitempty" json:"id,omitempty" yaml:"id,omitempty"`
+	Title       *string `xml:"title,omitempty" json:"title,omitempty" yaml:"title,omitempty"`
+	Description *string `xml:"description,omitempty" json:"description,omitempty" yaml:"description,omitempty"`
+	ReleaseDate *Date   `xml:"releaseDate,omitempty" json:"releaseDate,omitempty" yaml:"releaseDate,omitempty"`
+}
+
+// UpdateMovieResponse was auto-generated from WSDL.
+type UpdateMovieResponse struct {
+	Id          *int64  `xml:"id,omitempty" json:"id,omitempty" yaml:"id,omitempty"`
+	Title       *string `xml:"title,omitempty" json:"title,omitempty" yaml:"title,omitempty"`
+	Description *string `xml:"description,omitempty" json:"description,omitempty" yaml:"description,omitempty"`
+	ReleaseDate *Date   `xml:"releaseDate,omitempty" json:"releaseDate,omitempty" yaml:"releaseDate,omitempty"`
+}
+
+// UploadPosterRequest was auto-generated from WSDL.
+type UploadPosterRequest struct {
+	MovieId *int64  `xml:"movieId,omitempty" json:"movieId,omitempty" yaml:"movieId,omitempty"`
+	Image   *[]byte `xml:"image,omitempty" json:"image,omitempty" yaml:"image,omitempty"`
+}
+
+// UploadPosterResponse was auto-generated from WSDL.
+type UploadPosterResponse struct {
+	MovieId     *int64  `xml:"movieId,omitempty" json:"movieId,omitempty" yaml:"movieId,omitempty"`
+	ContentType *string `xml:"contentType,omitempty" json:"contentType,omitempty" yaml:"contentType,omitempty"`
+	Size        *int64  `xml:"size,omitempty" json:"size,omitempty" yaml:"size,omitempty"`
+}
+
+// Operation wrapper for CreateMovie.
+// OperationCreateMovieRequest was auto-generated from WSDL.
+type OperationCreateMovieRequest struct {
+	CreateMovieRequest *CreateMovieRequest `xml:"CreateMovieRequest,omitempty" json:"CreateMovieRequest,omitempty" yaml:"CreateMovieRequest,omitempty"`
+}
+
+// Operation wrapper for CreateMovie.
+// OperationCreateMovieResponse was auto-generated from WSDL.
+type OperationCreateMovieResponse struct {
+	CreateMovieResponse *CreateMovieResponse `xml:"CreateMovieResponse,omitempty" json:"CreateMovieResponse,omitempty" yaml:"CreateMovieResponse,omitempty"`
+}
+
+// Operation wrapper for DeleteMovie.
+// OperationDeleteMovieRequest was auto-generated from WSDL.
+type OperationDeleteMovieRequest struct {
+	DeleteMovieRequest *DeleteMovieRequest `xml:"DeleteMovieRequest,omitempty" json:"DeleteMovieRequest,omitempty" yaml:"DeleteMovieRequest,omitempty"`
+}
+
+// Operation wrapper for DeleteMovie.
+// OperationDeleteMovieResponse was auto-generated from WSDL.
+type OperationDeleteMovieResponse struct {
+	DeleteMovieResponse *DeleteMovieResponse `xml:"DeleteMovieResponse,omitempty" json:"DeleteMovieResponse,omitempty" yaml:"DeleteMovieResponse,omitempty"`
 }
 
 // Operation wrapper for GetMovie.
@@ -46,11 +215,109 @@ type OperationGetMovieResponse struct {
 	GetMovieResponse *GetMovieResponse `xml:"GetMovieResponse,omitempty" json:"GetMovieResponse,omitempty" yaml:"GetMovieResponse,omitempty"`
 }
 
+// Operation wrapper for GetPoster.
+// OperationGetPosterRequest was auto-generated from WSDL.
+type OperationGetPosterRequest struct {
+	GetPosterRequest *GetPosterRequest `xml:"GetPosterRequest,omitempty" json:"GetPosterRequest,omitempty" yaml:"GetPosterRequest,omitempty"`
+}
+
+// Operation wrapper for GetPoster.
+// OperationGetPosterResponse was auto-generated from WSDL.
+type OperationGetPosterResponse struct {
+	GetPosterResponse *GetPosterResponse `xml:"GetPosterResponse,omitempty" json:"GetPosterResponse,omitempty" yaml:"GetPosterResponse,omitempty"`
+}
+
+// Operation wrapper for ListMovies.
+// OperationListMoviesRequest was auto-generated from WSDL.
+type OperationListMoviesRequest struct {
+	ListMoviesRequest *ListMoviesRequest `xml:"ListMoviesRequest,omitempty" json:"ListMoviesRequest,omitempty" yaml:"ListMoviesRequest,omitempty"`
+}
+
+// Operation wrapper for ListMovies.
+// OperationListMoviesResponse was auto-generated from WSDL.
+type OperationListMoviesResponse struct {
+	ListMoviesResponse *ListMoviesResponse `xml:"ListMoviesResponse,omitempty" json:"ListMoviesResponse,omitempty" yaml:"ListMoviesResponse,omitempty"`
+}
+
+// Operation wrapper for SearchMovies.
+// OperationSearchMoviesRequest was auto-generated from WSDL.
+type OperationSearchMoviesRequest struct {
+	SearchMoviesRequest *SearchMoviesRequest `xml:"SearchMoviesRequest,omitempty" json:"SearchMoviesRequest,omitempty" yaml:"SearchMoviesRequest,omitempty"`
+}
+
+// Operation wrapper for SearchMovies.
+// OperationSearchMoviesResponse was auto-generated from WSDL.
+type OperationSearchMoviesResponse struct {
+	SearchMoviesResponse *SearchMoviesResponse `xml:"SearchMoviesResponse,omitempty" json:"SearchMoviesResponse,omitempty" yaml:"SearchMoviesResponse,omitempty"`
+}
+
+// Operation wrapper for UpdateMovie.
+// OperationUpdateMovieRequest was auto-generated from WSDL.
+type OperationUpdateMovieRequest struct {
+	UpdateMovieRequest *UpdateMovieRequest `xml:"UpdateMovieRequest,omitempty" json:"UpdateMovieRequest,omitempty" yaml:"UpdateMovieRequest,omitempty"`
+}
+
+// Operation wrapper for UpdateMovie.
+// OperationUpdateMovieResponse was auto-generated from WSDL.
+type OperationUpdateMovieResponse struct {
+	UpdateMovieResponse *UpdateMovieResponse `xml:"UpdateMovieResponse,omitempty" json:"UpdateMovieResponse,omitempty" yaml:"UpdateMovieResponse,omitempty"`
+}
+
+// Operation wrapper for UploadPoster.
+// OperationUploadPosterRequest was auto-generated from WSDL.
+type OperationUploadPosterRequest struct {
+	UploadPosterRequest *UploadPosterRequest `xml:"UploadPosterRequest,omitempty" json:"UploadPosterRequest,omitempty" yaml:"UploadPosterRequest,omitempty"`
+}
+
+// Operation wrapper for UploadPoster.
+// OperationUploadPosterResponse was auto-generated from WSDL.
+type OperationUploadPosterResponse struct {
+	UploadPosterResponse *UploadPosterResponse `xml:"UploadPosterResponse,omitempty" json:"UploadPosterResponse,omitempty" yaml:"UploadPosterResponse,omitempty"`
+}
+
 // movieServicePortType implements the MovieServicePortType interface.
 type movieServicePortType struct {
 	cli *soap.Client
 }
 
+// CreateMovie was auto-generated from WSDL.
+func (p *movieServicePortType) CreateMovie(CreateMovieRequest *CreateMovieRequest) (*CreateMovieResponse, error) {
+	α := struct {
+		OperationCreateMovieRequest `xml:"tns:CreateMovie"`
+	}{
+		OperationCreateMovieRequest{
+			CreateMovieRequest,
+		},
+	}
+
+	γ := struct {
+		OperationCreateMovieResponse `xml:"CreateMovieResponse"`
+	}{}
+	if err := p.cli.RoundTripWithAction("CreateMovie", α, &γ); err != nil {
+		return nil, err
+	}
+	return γ.CreateMovieResponse, nil
+}
+
+// DeleteMovie was auto-generated from WSDL.
+func (p *movieServicePortType) DeleteMovie(DeleteMovieRequest *DeleteMovieRequest) (*DeleteMovieResponse, error) {
+	α := struct {
+		OperationDeleteMovieRequest `xml:"tns:DeleteMovie"`
+	}{
+		OperationDeleteMovieRequest{
+			DeleteMovieRequest,
+		},
+	}
+
+	γ := struct {
+		OperationDeleteMovieResponse `xml:"DeleteMovieResponse"`
+	}{}
+	if err := p.cli.RoundTripWithAction("DeleteMovie", α, &γ); err != nil {
+		return nil, err
+	}
+	return γ.DeleteMovieResponse, nil
+}
+
 // GetMovie was auto-generated from WSDL.
 func (p *movieServicePortType) GetMovie(GetMovieRequest *GetMovieRequest) (*GetMovieResponse, error) {
 	α := struct {
@@ -69,3 +336,98 @@ func (p *movieServicePortType) GetMovie(GetMovieRequest *GetMovieRequest) (*GetM
 	}
 	return γ.GetMovieResponse, nil
 }
+
+// GetPoster was auto-generated from WSDL.
+func (p *movieServicePortType) GetPoster(GetPosterRequest *GetPosterRequest) (*GetPosterResponse, error) {
+	α := struct {
+		OperationGetPosterRequest `xml:"tns:GetPoster"`
+	}{
+		OperationGetPosterRequest{
+			GetPosterRequest,
+		},
+	}
+
+	γ := struct {
+		OperationGetPosterResponse `xml:"GetPosterResponse"`
+	}{}
+	if err := p.cli.RoundTripWithAction("GetPoster", α, &γ); err != nil {
+		return nil, err
+	}
+	return γ.GetPosterResponse, nil
+}
+
+// ListMovies was auto-generated from WSDL.
+func (p *movieServicePortType) ListMovies(ListMoviesRequest *ListMoviesRequest) (*ListMoviesResponse, error) {
+	α := struct {
+		OperationListMoviesRequest `xml:"tns:ListMovies"`
+	}{
+		OperationListMoviesRequest{
+			ListMoviesRequest,
+		},
+	}
+
+	γ := struct {
+		OperationListMoviesResponse `xml:"ListMoviesResponse"`
+	}{}
+	if err := p.cli.RoundTripWithAction("ListMovies", α, &γ); err != nil {
+		return nil, err
+	}
+	return γ.ListMoviesResponse, nil
+}
+
+// SearchMovies was auto-generated from WSDL.
+func (p *movieServicePortType) SearchMovies(SearchMoviesRequest *SearchMoviesRequest) (*SearchMoviesResponse, error) {
+	α := struct {
+		OperationSearchMoviesRequest `xml:"tns:SearchMovies"`
+	}{
+		OperationSearchMoviesRequest{
+			SearchMoviesRequest,
+		},
+	}
+
+	γ := struct {
+		OperationSearchMoviesResponse `xml:"SearchMoviesResponse"`
+	}{}
+	if err := p.cli.RoundTripWithAction("SearchMovies", α, &γ); err != nil {
+		return nil, err
+	}
+	return γ.SearchMoviesResponse, nil
+}
+
+// UpdateMovie was auto-generated from WSDL.
+func (p *movieServicePortType) UpdateMovie(UpdateMovieRequest *UpdateMovieRequest) (*UpdateMovieResponse, error) {
+	α := struct {
+		OperationUpdateMovieRequest `xml:"tns:UpdateMovie"`
+	}{
+		OperationUpdateMovieRequest{
+			UpdateMovieRequest,
+		},
+	}
+
+	γ := struct {
+		OperationUpdateMovieResponse `xml:"UpdateMovieResponse"`
+	}{}
+	if err := p.cli.RoundTripWithAction("UpdateMovie", α, &γ); err != nil {
+		return nil, err
+	}
+	return γ.UpdateMovieResponse, nil
+}
+
+// UploadPoster was auto-generated from WSDL.
+func (p *movieServicePortType) UploadPoster(UploadPosterRequest *UploadPosterRequest) (*UploadPosterResponse, error) {
+	α := struct {
+		OperationUploadPosterRequest `xml:"tns:UploadPoster"`
+	}{
+		OperationUploadPosterRequest{
+			UploadPosterRequest,
+		},
+	}
+
+	γ := struct {
+		OperationUploadPosterResponse `xml:"UploadPosterResponse"`
+	}{}
+	if err := p.cli.RoundTripWithAction("UploadPoster", α, &γ); err != nil {
+		return nil, err
+	}
+	return γ.UploadPosterResponse, nil
+}
@@ -0,0 +1,45 @@
+package soaphandler
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sorrawichYooboon/go-protocol-api-style/internal/domain"
+	"github.com/sorrawichYooboon/go-protocol-api-style/internal/infrastructure/soap/wsse"
+	"github.com/sorrawichYooboon/go-protocol-api-style/internal/usecase"
+)
+
+// authenticate checks the WS-Security header, or the <APIKey> header when
+// there is none, and stores the principal in the request context. It writes
+// the fault itself and returns false on failure.
+func (h *MovieSOAPHandler) authenticate(c *gin.Context, header *SOAPHeader) bool {
+	var (
+		principal *domain.Principal
+		err       error
+	)
+	if header != nil && header.Security != nil {
+		principal, err = h.wsseValidator.Validate(header.Security)
+		var secErr *wsse.Error
+		if errors.As(err, &secErr) {
+			h.writeFault(c, SOAPFault{XmlnsWSSE: wsse.Namespace, Code: secErr.Code, String: secErr.Message})
+			return false
+		} else if err != nil {
+			h.writeSOAPError(c, err)
+			return false
+		}
+	} else {
+		var key string
+		if header != nil {
+			key = strings.TrimSpace(header.APIKey)
+		}
+		principal, err = h.authUsecase.AuthenticateAPIKey(c.Request.Context(), key)
+		if err != nil {
+			h.writeSOAPError(c, err)
+			return false
+		}
+	}
+
+	c.Request = c.Request.WithContext(usecase.WithPrincipal(c.Request.Context(), principal))
+	return true
+}
@@ -0,0 +1,157 @@
+package soaphandler
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"math"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	movieservicebinding "github.com/sorrawichYooboon/go-protocol-api-style/internal/infrastructure/soap/gen"
+	"github.com/sorrawichYooboon/go-protocol-api-style/internal/usecase"
+	"github.com/sorrawichYooboon/go-protocol-api-style/logger"
+)
+
+// Fault codes are written in their SOAP 1.1 form and translated when the
+// request is SOAP 1.2.
+const (
+	faultCodeClient          = "soapenv:Client"
+	faultCodeServer          = "soapenv:Server"
+	faultCodeVersionMismatch = "soapenv:VersionMismatch"
+)
+
+var soap12FaultCodes = map[string]string{
+	faultCodeClient:          "soapenv:Sender",
+	faultCodeServer:          "soapenv:Receiver",
+	faultCodeVersionMismatch: "soapenv:VersionMismatch",
+}
+
+type SOAPFault struct {
+	XMLName xml.Name `xml:"soapenv:Fault"`
+	// XmlnsWSSE declares the prefix of wsse:* fault codes.
+	XmlnsWSSE string           `xml:"xmlns:wsse,attr,omitempty"`
+	Code      string           `xml:"faultcode"`
+	String    string           `xml:"faultstring"`
+	Detail    *SOAPFaultDetail `xml:"detail,omitempty"`
+}
+
+type SOAPFaultDetail struct {
+	MovieFault *movieservicebinding.MovieFault `xml:"MovieFault"`
+}
+
+// SOAP12Fault is the SOAP 1.2 Code/Reason/Detail form of SOAPFault.
+type SOAP12Fault struct {
+	XMLName   xml.Name         `xml:"soapenv:Fault"`
+	XmlnsWSSE string           `xml:"xmlns:wsse,attr,omitempty"`
+	Code      SOAP12FaultCode  `xml:"soapenv:Code"`
+	Reason    SOAP12Reason     `xml:"soapenv:Reason"`
+	Detail    *SOAPFaultDetail `xml:"soapenv:Detail,omitempty"`
+}
+
+type SOAP12FaultCode struct {
+	Value   string           `xml:"soapenv:Value"`
+	Subcode *SOAP12FaultCode `xml:"soapenv:Subcode,omitempty"`
+}
+
+type SOAP12Reason struct {
+	Text SOAP12Text `xml:"soapenv:Text"`
+}
+
+type SOAP12Text struct {
+	Lang  string `xml:"xml:lang,attr"`
+	Value string `xml:",chardata"`
+}
+
+var faultCodes = map[usecase.ErrorCode]string{
+	usecase.ErrCodeNotFound:          faultCodeClient,
+	usecase.ErrCodeInvalidArgument:   faultCodeClient,
+	usecase.ErrCodeConflict:          faultCodeClient,
+	usecase.ErrCodeUnauthenticated:   faultCodeClient,
+	usecase.ErrCodePermissionDenied:  faultCodeClient,
+	usecase.ErrCodeUnavailable:       faultCodeServer,
+	usecase.ErrCodeResourceExhausted: faultCodeServer,
+	usecase.ErrCodeInternal:          faultCodeServer,
+}
+
+// AbortWithFault lets middleware in front of the SOAP endpoint answer with
+// a regular fault envelope.
+func (h *MovieSOAPHandler) AbortWithFault(c *gin.Context, err error) {
+	h.writeSOAPError(c, err)
+	c.Abort()
+}
+
+// writeSOAPError reports a usecase error as a fault whose <detail> carries
+// the typed error code and any field violations.
+func (h *MovieSOAPHandler) writeSOAPError(c *gin.Context, err error) {
+	if errors.Is(err, context.Canceled) && c.Request.Context().Err() != nil {
+		c.Abort()
+		return
+	}
+
+	ucErr := usecase.AsError(err)
+	if ucErr.Code == usecase.ErrCodeInternal {
+		logger.LogError("soap.MovieSOAPHandler", err)
+	}
+
+	if ucErr.RetryAfter > 0 {
+		c.Header("Retry-After", strconv.Itoa(int(math.Ceil(ucErr.RetryAfter.Seconds()))))
+	}
+
+	code := string(ucErr.Code)
+	detail := &movieservicebinding.MovieFault{
+		Code:    &code,
+		Message: &ucErr.Message,
+	}
+	for _, v := range ucErr.Violations {
+		detail.Violation = append(detail.Violation, &movieservicebinding.FieldViolation{
+			Field:       &v.Field,
+			Description: &v.Description,
+		})
+	}
+	h.writeFault(c, SOAPFault{
+		Code:   faultCodes[ucErr.Code],
+		String: ucErr.Message,
+		Detail: &SOAPFaultDetail{MovieFault: detail},
+	})
+}
+
+func (h *MovieSOAPHandler) writeSOAPFault(c *gin.Context, code, msg string) {
+	h.writeFault(c, SOAPFault{Code: code, String: msg})
+}
+
+// The SOAP 1.1 HTTP binding sends every fault with status 500; SOAP 1.2
+// uses 400 for Sender faults.
+func (h *MovieSOAPHandler) writeFault(c *gin.Context, fault SOAPFault) {
+	if versionOf(c) == soap11 {
+		out, _ := xml.Marshal(fault)
+		writeEnvelope(c, http.StatusInternalServerError, string(out))
+		return
+	}
+
+	fault12 := toSOAP12Fault(fault)
+	status := http.StatusInternalServerError
+	if fault12.Code.Value == soap12FaultCodes[faultCodeClient] {
+		status = http.StatusBadRequest
+	}
+	out, _ := xml.Marshal(fault12)
+	writeEnvelope(c, status, string(out))
+}
+
+// toSOAP12Fault keeps codes outside the envelope namespace, such as
+// wsse:FailedAuthentication, as a Subcode of Sender.
+func toSOAP12Fault(fault SOAPFault) SOAP12Fault {
+	code := SOAP12FaultCode{Value: soap12FaultCodes[faultCodeClient]}
+	if v, ok := soap12FaultCodes[fault.Code]; ok {
+		code.Value = v
+	} else {
+		code.Subcode = &SOAP12FaultCode{Value: fault.Code}
+	}
+	return SOAP12Fault{
+		XmlnsWSSE: fault.XmlnsWSSE,
+		Code:      code,
+		Reason:    SOAP12Reason{Text: SOAP12Text{Lang: "en", Value: fault.String}},
+		Detail:    fault.Detail,
+	}
+}
@@ -3,26 +3,27 @@ package soaphandler
 import (
 	"bytes"
 	"encoding/xml"
-	"errors"
-	"io"
 	"net/http"
-	"os"
 	"strings"
 
 	"github.com/gin-gonic/gin"
+	"github.com/sorrawichYooboon/go-protocol-api-style/internal/domain"
+	"github.com/sorrawichYooboon/go-protocol-api-style/internal/infrastructure/ratelimit"
 	movieservicebinding "github.com/sorrawichYooboon/go-protocol-api-style/internal/infrastructure/soap/gen"
+	"github.com/sorrawichYooboon/go-protocol-api-style/internal/infrastructure/soap/wsdl"
+	"github.com/sorrawichYooboon/go-protocol-api-style/internal/infrastructure/soap/wsse"
 	"github.com/sorrawichYooboon/go-protocol-api-style/internal/usecase"
 )
 
-const soapEnv = "http://schemas.xmlsoap.org/soap/envelope/"
-
 type SOAPEnvelope struct {
 	XMLName xml.Name    `xml:"Envelope"`
 	Header  *SOAPHeader `xml:"Header,omitempty"`
 	Body    SOAPBody    `xml:"Body"`
 }
 type SOAPHeader struct {
-	Raw string `xml:",innerxml"`
+	APIKey   string         `xml:"APIKey"`
+	Security *wsse.Security `xml:"Security"`
+	Raw      string         `xml:",innerxml"`
 }
 type SOAPBody struct {
 	Raw string `xml:",innerxml"`
@@ -34,132 +35,333 @@ type SOAPEnvelopeResponse struct {
 	Body    SOAPBody `xml:"soapenv:Body"`
 }
 
-type SOAPFault struct {
-	XMLName xml.Name `xml:"soapenv:Fault"`
-	Code    string   `xml:"faultcode"`
-	String  string   `xml:"faultstring"`
-}
-
 type MovieSOAPHandler struct {
-	movieUsecase usecase.MovieUsecase
+	movieUsecase  usecase.MovieUsecase
+	authUsecase   usecase.AuthUsecase
+	posterUsecase usecase.PosterUsecase
+	wsseValidator *wsse.Validator
+	limiter       *ratelimit.Limiter
+	publicURL     string
 }
 
-func NewMovieSOAPHandler(movieUsecase usecase.MovieUsecase) *MovieSOAPHandler {
-	return &MovieSOAPHandler{movieUsecase: movieUsecase}
+// Credentials travel in the envelope, so limiter, when not nil, is applied
+// per principal here once the request is authenticated. publicURL is the
+// externally visible base URL used in the WSDL's soap:address. When empty it
+// is derived from each WSDL request.
+func NewMovieSOAPHandler(movieUsecase usecase.MovieUsecase, posterUsecase usecase.PosterUsecase, authUsecase usecase.AuthUsecase, wsseValidator *wsse.Validator, limiter *ratelimit.Limiter, publicURL string) *MovieSOAPHandler {
+	return &MovieSOAPHandler{
+		movieUsecase:  movieUsecase,
+		posterUsecase: posterUsecase,
+		authUsecase:   authUsecase,
+		wsseValidator: wsseValidator,
+		limiter:       limiter,
+		publicURL:     strings.TrimSuffix(publicURL, "/"),
+	}
 }
 
 func (h *MovieSOAPHandler) Handle(c *gin.Context) {
 	if c.Request.Method != http.MethodPost {
-		h.writeSOAPFault(c, "Client", "Only POST is allowed")
+		h.writeSOAPFault(c, faultCodeClient, "Only POST is allowed")
 		return
 	}
-	body, err := io.ReadAll(io.LimitReader(c.Request.Body, 1<<20))
+	body, attachments, err := readMessage(c)
 	if err != nil {
-		h.writeSOAPFault(c, "Client", "Could not read request body")
+		h.writeSOAPFault(c, faultCodeClient, err.Error())
 		return
 	}
 
 	var envelope SOAPEnvelope
 	if err := xml.Unmarshal(body, &envelope); err != nil {
-		h.writeSOAPFault(c, "Client", "Invalid SOAP envelope")
+		h.writeSOAPFault(c, faultCodeClient, "Invalid SOAP envelope")
 		return
 	}
-
-	action, actionBody, err := getSOAPAction(envelope.Body.Raw)
-	if err != nil {
-		h.writeSOAPFault(c, "Client", err.Error())
+	version, ok := envelopeVersion(envelope.XMLName.Space)
+	if !ok {
+		h.writeSOAPFault(c, faultCodeVersionMismatch, "Envelope must be SOAP 1.1 or SOAP 1.2")
 		return
 	}
+	c.Set(soapVersionKey, version)
 
-	switch action {
-	case "GetMovieRequest":
-		var req movieservicebinding.GetMovieRequest
-		if err := xml.Unmarshal([]byte(actionBody), &req); err != nil || req.Id == nil {
-			h.writeSOAPFault(c, "Client", "Invalid GetMovieRequest")
+	if !h.authenticate(c, envelope.Header) {
+		return
+	}
+	if h.limiter != nil {
+		if ok, retryAfter := h.limiter.Allow(ratelimit.ClientKey(c.Request.Context(), c.ClientIP())); !ok {
+			h.writeSOAPError(c, usecase.NewResourceExhaustedError(retryAfter))
 			return
 		}
-		h.processGetMovie(c, req)
-	default:
-		h.writeSOAPFault(c, "Client", "Unknown SOAP action: "+action)
 	}
+
+	bodyElement, err := readBodyElement(body)
+	if err != nil {
+		h.writeSOAPFault(c, faultCodeClient, errNoBodyElement.Error())
+		return
+	}
+	bodyElement, err = resolveXOP(bodyElement, attachments)
+	if err != nil {
+		h.writeSOAPFault(c, faultCodeClient, err.Error())
+		return
+	}
+	route, request, err := movieRouter.route(requestAction(c), bodyElement)
+	if err != nil {
+		h.writeSOAPFault(c, faultCodeClient, err.Error())
+		return
+	}
+	if !h.validate(c, route.op, request) {
+		return
+	}
+	route.handle(h, c, replay(request))
 }
 
 func (h *MovieSOAPHandler) processGetMovie(c *gin.Context, req movieservicebinding.GetMovieRequest) {
-	movie, err := h.movieUsecase.GetMovieByID(*req.Id)
-	if err != nil || movie == nil {
-		h.writeSOAPFault(c, "Server", "Movie not found")
+	movie, err := h.movieUsecase.GetMovieByID(c.Request.Context(), *req.Id)
+	if err != nil {
+		h.writeSOAPError(c, err)
+		return
+	}
+
+	h.writeSOAPResponse(c, movieservicebinding.GetMovieResponse{
+		Id:          &movie.ID,
+		Title:       &movie.Title,
+		Description: &movie.Description,
+		ReleaseDate: soapDate(movie.ReleaseDate),
+	})
+}
+
+func (h *MovieSOAPHandler) processListMovies(c *gin.Context, req movieservicebinding.ListMoviesRequest) {
+	pageSize := 0
+	if req.PageSize != nil {
+		pageSize = *req.PageSize
+	}
+	filter, sort, err := toMovieListSpec(req)
+	if err != nil {
+		h.writeSOAPError(c, err)
+		return
+	}
+	page, err := h.movieUsecase.ListMovies(c.Request.Context(), filter, sort, pageSize, stringValue(req.PageToken))
+	if err != nil {
+		h.writeSOAPError(c, usecase.RenameViolations(err, pageElements))
+		return
+	}
+
+	movies, nextPageToken := toSOAPMoviePage(page)
+	h.writeSOAPResponse(c, movieservicebinding.ListMoviesResponse{Movie: movies, NextPageToken: nextPageToken})
+}
+
+func (h *MovieSOAPHandler) processSearchMovies(c *gin.Context, req movieservicebinding.SearchMoviesRequest) {
+	pageSize := 0
+	if req.PageSize != nil {
+		pageSize = *req.PageSize
+	}
+	page, err := h.movieUsecase.SearchMovies(c.Request.Context(), *req.Query, pageSize, stringValue(req.PageToken))
+	if err != nil {
+		h.writeSOAPError(c, usecase.RenameViolations(err, pageElements))
+		return
+	}
+
+	movies, nextPageToken := toSOAPMoviePage(page)
+	h.writeSOAPResponse(c, movieservicebinding.SearchMoviesResponse{Movie: movies, NextPageToken: nextPageToken})
+}
+
+func toSOAPMoviePage(page *domain.MoviePage) ([]*movieservicebinding.Movie, *string) {
+	movies := make([]*movieservicebinding.Movie, 0, len(page.Edges))
+	for i := range page.Edges {
+		m := &page.Edges[i].Movie
+		movies = append(movies, &movieservicebinding.Movie{
+			Id:          &m.ID,
+			Title:       &m.Title,
+			Description: &m.Description,
+			ReleaseDate: soapDate(m.ReleaseDate),
+		})
+	}
+	if page.NextCursor == "" {
+		return movies, nil
+	}
+	return movies, &page.NextCursor
+}
+
+func (h *MovieSOAPHandler) processCreateMovie(c *gin.Context, req movieservicebinding.CreateMovieRequest) {
+	releaseDate, err := parseSOAPDate(*req.ReleaseDate)
+	if err != nil {
+		h.writeSOAPError(c, err)
+		return
+	}
+
+	movie, err := h.movieUsecase.CreateMovie(c.Request.Context(), &domain.Movie{
+		Title:       *req.Title,
+		Description: stringValue(req.Description),
+		ReleaseDate: releaseDate,
+	})
+	if err != nil {
+		h.writeSOAPError(c, err)
 		return
 	}
 
-	resp := movieservicebinding.GetMovieResponse{
+	h.writeSOAPResponse(c, movieservicebinding.CreateMovieResponse{
 		Id:          &movie.ID,
 		Title:       &movie.Title,
 		Description: &movie.Description,
-		ReleaseDate: &movie.ReleaseDate,
+		ReleaseDate: soapDate(movie.ReleaseDate),
+	})
+}
+
+func (h *MovieSOAPHandler) processUpdateMovie(c *gin.Context, req movieservicebinding.UpdateMovieRequest) {
+	releaseDate, err := parseSOAPDate(*req.ReleaseDate)
+	if err != nil {
+		h.writeSOAPError(c, err)
+		return
+	}
+
+	movie, err := h.movieUsecase.UpdateMovie(c.Request.Context(), &domain.Movie{
+		ID:          *req.Id,
+		Title:       *req.Title,
+		Description: stringValue(req.Description),
+		ReleaseDate: releaseDate,
+	})
+	if err != nil {
+		h.writeSOAPError(c, err)
+		return
+	}
+
+	h.writeSOAPResponse(c, movieservicebinding.UpdateMovieResponse{
+		Id:          &movie.ID,
+		Title:       &movie.Title,
+		Description: &movie.Description,
+		ReleaseDate: soapDate(movie.ReleaseDate),
+	})
+}
+
+func (h *MovieSOAPHandler) processDeleteMovie(c *gin.Context, req movieservicebinding.DeleteMovieRequest) {
+	movie, err := h.movieUsecase.DeleteMovie(c.Request.Context(), *req.Id)
+	if err != nil {
+		h.writeSOAPError(c, err)
+		return
 	}
+
+	h.writeSOAPResponse(c, movieservicebinding.DeleteMovieResponse{
+		Id:          &movie.ID,
+		Title:       &movie.Title,
+		Description: &movie.Description,
+		ReleaseDate: soapDate(movie.ReleaseDate),
+	})
+}
+
+func (h *MovieSOAPHandler) writeSOAPResponse(c *gin.Context, resp any) {
 	out, _ := xml.Marshal(resp)
+	writeEnvelope(c, http.StatusOK, string(out))
+}
+
+// writeEnvelope wraps body in an envelope of the request's SOAP version.
+func writeEnvelope(c *gin.Context, status int, body string) {
+	version := versionOf(c)
+	c.Data(status, version.contentType(), envelopeBytes(version, body))
+}
+
+func envelopeBytes(version soapVersion, body string) []byte {
 	envelope := SOAPEnvelopeResponse{
-		Xmlns: soapEnv,
-		Body:  SOAPBody{Raw: string(out)},
+		Xmlns: version.namespace(),
+		Body:  SOAPBody{Raw: body},
 	}
 	var buf bytes.Buffer
 	buf.WriteString(xml.Header)
 	xml.NewEncoder(&buf).Encode(envelope)
-	c.Data(http.StatusOK, "text/xml; charset=utf-8", buf.Bytes())
+	return buf.Bytes()
 }
 
-func (h *MovieSOAPHandler) writeSOAPFault(c *gin.Context, code, msg string) {
-	fault := SOAPFault{Code: code, String: msg}
-	out, _ := xml.Marshal(fault)
-	envelope := SOAPEnvelopeResponse{
-		Xmlns: soapEnv,
-		Body:  SOAPBody{Raw: string(out)},
+// soapDate omits a missing date, because an empty element is not a valid
+// xsd:date.
+func soapDate(d domain.Date) *movieservicebinding.Date {
+	if d.IsZero() {
+		return nil
 	}
-	var buf bytes.Buffer
-	buf.WriteString(xml.Header)
-	xml.NewEncoder(&buf).Encode(envelope)
-	c.Data(http.StatusOK, "text/xml; charset=utf-8", buf.Bytes())
+	date := movieservicebinding.Date(d.String())
+	return &date
+}
+
+// parseSOAPDate reads a date the schema validator has already accepted.
+func parseSOAPDate(d movieservicebinding.Date) (domain.Date, error) {
+	date, err := wsdl.ParseDate(strings.TrimSpace(string(d)))
+	if err != nil {
+		return domain.Date{}, usecase.ErrInvalidReleaseDate
+	}
+	return date, nil
+}
+
+// pageElements maps the usecase field names that differ from the SOAP
+// element names; the others, e.g. releasedFrom, are spelled the same.
+var pageElements = map[string]string{
+	"cursor": "pageToken",
 }
 
-func getSOAPAction(body string) (string, string, error) {
-	d := xml.NewDecoder(strings.NewReader(body))
-	for {
-		tok, err := d.Token()
+var soapSortFields = map[string]domain.MovieSortField{
+	"id":          domain.MovieSortByID,
+	"title":       domain.MovieSortByTitle,
+	"releaseDate": domain.MovieSortByReleaseDate,
+}
+
+func toMovieListSpec(req movieservicebinding.ListMoviesRequest) (domain.MovieFilter, domain.MovieSort, error) {
+	var violations []usecase.FieldViolation
+	filter := domain.MovieFilter{TitleContains: stringValue(req.TitleContains)}
+	for _, d := range []struct {
+		field string
+		value *movieservicebinding.Date
+		date  *domain.Date
+	}{
+		{"releasedFrom", req.ReleasedFrom, &filter.ReleasedFrom},
+		{"releasedTo", req.ReleasedTo, &filter.ReleasedTo},
+	} {
+		if d.value == nil {
+			continue
+		}
+		date, err := wsdl.ParseDate(strings.TrimSpace(string(*d.value)))
 		if err != nil {
-			break
+			violations = append(violations, usecase.FieldViolation{Field: d.field, Description: "must be an xsd:date"})
 		}
-		switch se := tok.(type) {
-		case xml.StartElement:
-			name := se.Name.Local
-			var elemBuf bytes.Buffer
-			e := xml.NewEncoder(&elemBuf)
-			e.EncodeToken(se)
-			depth := 1
-			for depth > 0 {
-				tok, err := d.Token()
-				if err != nil {
-					return "", "", err
-				}
-				switch tok.(type) {
-				case xml.StartElement:
-					depth++
-				case xml.EndElement:
-					depth--
-				}
-				e.EncodeToken(tok)
-			}
-			e.Flush()
-			return name, elemBuf.String(), nil
+		*d.date = date
+	}
+
+	var sort domain.MovieSort
+	if req.SortBy != nil {
+		field, ok := soapSortFields[strings.TrimSpace(*req.SortBy)]
+		if !ok {
+			violations = append(violations, usecase.FieldViolation{Field: "sortBy", Description: "must be one of id, title or releaseDate"})
 		}
+		sort.Field = field
+	}
+	sort.Descending = req.Descending != nil && *req.Descending
+
+	if len(violations) > 0 {
+		return domain.MovieFilter{}, domain.MovieSort{}, usecase.NewInvalidArgumentError("invalid request", violations...)
+	}
+	return filter, sort, nil
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
 	}
-	return "", "", errors.New("no SOAP action found")
+	return *s
 }
 
 func (h *MovieSOAPHandler) ServeWSDL(c *gin.Context) {
-	wsdlBytes, err := os.ReadFile("internal/infrastructure/soap/wsdl/movie_service.wsdl")
-	if err != nil {
-		c.String(http.StatusInternalServerError, "WSDL not found")
+	var buf bytes.Buffer
+	if err := wsdl.MovieService.Render(&buf, h.endpointURL(c)); err != nil {
+		c.String(http.StatusInternalServerError, "Could not render WSDL")
 		return
 	}
-	c.Data(http.StatusOK, "text/xml; charset=utf-8", wsdlBytes)
+	c.Data(http.StatusOK, "text/xml; charset=utf-8", buf.Bytes())
+}
+
+// endpointURL is the address of the endpoint the WSDL at the requested path
+// describes, e.g. /soap/movie for /soap/movie.wsdl.
+func (h *MovieSOAPHandler) endpointURL(c *gin.Context) string {
+	path := strings.TrimSuffix(c.Request.URL.Path, ".wsdl")
+	if h.publicURL != "" {
+		return h.publicURL + path
+	}
+	scheme := "http"
+	if c.Request.TLS != nil || c.GetHeader("X-Forwarded-Proto") == "https" {
+		scheme = "https"
+	}
+	return scheme + "://" + c.Request.Host + path
 }
@@ -0,0 +1,152 @@
+package soaphandler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sorrawichYooboon/go-protocol-api-style/internal/domain"
+	"github.com/sorrawichYooboon/go-protocol-api-style/internal/infrastructure/soap/wsse"
+	mockRepo "github.com/sorrawichYooboon/go-protocol-api-style/internal/mock"
+	"github.com/sorrawichYooboon/go-protocol-api-style/internal/usecase"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestMovieSOAPHandler_WriteOperations(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	mockMovieRepo := mockRepo.NewMovieRepository(t)
+	mockMovieEventBus := mockRepo.NewMovieEventBus(t)
+	mockAPIKeyRepo := mockRepo.NewAPIKeyRepository(t)
+
+	clearAllMock := func() {
+		mockMovieRepo.ClearAll()
+		mockMovieEventBus.ClearAll()
+	}
+
+	// The hash is the SHA-256 of "secret", the key sent in the envelope header.
+	mockAPIKeyRepo.On("GetByHash", mock.Anything, "2bb80d537b1da3e38bd30361aa855686bde0eacd7162fef6a25fe97bf527a25b").
+		Return(&domain.APIKey{ID: 1, Name: "test", Scopes: usecase.ScopeMoviesRead + " " + usecase.ScopeMoviesWrite}, nil)
+
+	h := NewMovieSOAPHandler(
+		usecase.NewMovieUsecase(mockMovieRepo, nil, mockMovieEventBus),
+		nil,
+		usecase.NewAuthUsecase(mockAPIKeyRepo),
+		wsse.NewValidator(wsse.Config{}),
+		nil,
+		"",
+	)
+	envelope := func(body string) string {
+		return `<s:Envelope xmlns:s="http://schemas.xmlsoap.org/soap/envelope/" xmlns:tns="http://example.com/moviesoap"><s:Header><tns:APIKey>secret</tns:APIKey></s:Header><s:Body>` + body + `</s:Body></s:Envelope>`
+	}
+
+	dune := &domain.Movie{ID: 4, Title: "Dune", ReleaseDate: domain.Date{Year: 2021, Month: time.October, Day: 22}}
+	duneResponse := `<id>4</id><title>Dune</title><description></description><releaseDate>2021-10-22</releaseDate>`
+
+	tests := []struct {
+		name string
+		body string
+
+		wantServiceOrRepoCallWithAndResponse func()
+		wantStatus                           int
+		wantBody                             []string
+	}{
+		{
+			name:       "create with invalid release date",
+			body:       `<CreateMovieRequest><title>Dune</title><releaseDate>22/10/2021</releaseDate></CreateMovieRequest>`,
+			wantStatus: http.StatusInternalServerError,
+			wantBody:   []string{"<code>INVALID_ARGUMENT</code>", "<field>CreateMovieRequest/releaseDate</field>"},
+		},
+		{
+			name:       "create with empty title",
+			body:       `<CreateMovieRequest><title> </title><releaseDate>2021-10-22</releaseDate></CreateMovieRequest>`,
+			wantStatus: http.StatusInternalServerError,
+			wantBody:   []string{"<code>INVALID_ARGUMENT</code>", "<field>title</field>"},
+		},
+		{
+			name: "create",
+			body: `<CreateMovieRequest><title>Dune</title><releaseDate>2021-10-22</releaseDate></CreateMovieRequest>`,
+			wantServiceOrRepoCallWithAndResponse: func() {
+				mockMovieRepo.On("Create", mock.Anything, &domain.Movie{Title: "Dune", ReleaseDate: dune.ReleaseDate}).Return(dune, nil)
+				mockMovieEventBus.On("Publish", mock.Anything).Return()
+			},
+			wantStatus: http.StatusOK,
+			wantBody:   []string{"CreateMovieResponse", duneResponse},
+		},
+		{
+			name:       "update without id",
+			body:       `<UpdateMovieRequest><title>Dune</title><releaseDate>2021-10-22</releaseDate></UpdateMovieRequest>`,
+			wantStatus: http.StatusInternalServerError,
+			wantBody:   []string{"<code>INVALID_ARGUMENT</code>", "<field>UpdateMovieRequest/id</field>"},
+		},
+		{
+			name: "update missing movie",
+			body: `<UpdateMovieRequest><id>7</id><title>Dune</title><releaseDate>2021-10-22</releaseDate></UpdateMovieRequest>`,
+			wantServiceOrRepoCallWithAndResponse: func() {
+				mockMovieRepo.On("Update", mock.Anything, mock.Anything).Return(nil, nil)
+			},
+			wantStatus: http.StatusInternalServerError,
+			wantBody:   []string{"<code>NOT_FOUND</code>", "movie 7 not found"},
+		},
+		{
+			name: "update",
+			body: `<UpdateMovieRequest><id>4</id><title>Dune</title><releaseDate>2021-10-22</releaseDate></UpdateMovieRequest>`,
+			wantServiceOrRepoCallWithAndResponse: func() {
+				mockMovieRepo.On("Update", mock.Anything, dune).Return(dune, nil)
+				mockMovieEventBus.On("Publish", mock.Anything).Return()
+			},
+			wantStatus: http.StatusOK,
+			wantBody:   []string{"UpdateMovieResponse", duneResponse},
+		},
+		{
+			name:       "delete with invalid id",
+			body:       `<DeleteMovieRequest><id>abc</id></DeleteMovieRequest>`,
+			wantStatus: http.StatusInternalServerError,
+			wantBody:   []string{"<code>INVALID_ARGUMENT</code>", "<field>DeleteMovieRequest/id</field>"},
+		},
+		{
+			name: "delete missing movie",
+			body: `<DeleteMovieRequest><id>7</id></DeleteMovieRequest>`,
+			wantServiceOrRepoCallWithAndResponse: func() {
+				mockMovieRepo.On("Delete", mock.Anything, int64(7)).Return(nil, nil)
+			},
+			wantStatus: http.StatusInternalServerError,
+			wantBody:   []string{"<code>NOT_FOUND</code>", "movie 7 not found"},
+		},
+		{
+			name: "delete",
+			body: `<DeleteMovieRequest><id>4</id></DeleteMovieRequest>`,
+			wantServiceOrRepoCallWithAndResponse: func() {
+				mockMovieRepo.On("Delete", mock.Anything, int64(4)).Return(dune, nil)
+				mockMovieEventBus.On("Publish", mock.Anything).Return()
+			},
+			wantStatus: http.StatusOK,
+			wantBody:   []string{"DeleteMovieResponse", duneResponse},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			defer clearAllMock()
+
+			if test.wantServiceOrRepoCallWithAndResponse != nil {
+				test.wantServiceOrRepoCallWithAndResponse()
+			}
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest(http.MethodPost, "/soap/movie", strings.NewReader(envelope(test.body)))
+			c.Request.Header.Set("Content-Type", "text/xml")
+			h.Handle(c)
+
+			assert.Equal(t, test.wantStatus, w.Code, w.Body.String())
+			for _, want := range test.wantBody {
+				assert.Contains(t, w.Body.String(), want)
+			}
+		})
+	}
+}
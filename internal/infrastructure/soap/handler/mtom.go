@@ -0,0 +1,209 @@
+package soaphandler
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"net/url"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	xopNamespace = "http://www.w3.org/2004/08/xop/include"
+	xopMediaType = "application/xop+xml"
+
+	multipartRelated = "multipart/related"
+	mtomRootCID      = "root@moviesoap"
+	mtomKey          = "soap.mtom"
+
+	// Large enough for a poster sent inline as base64.
+	maxRequestSize = 8 << 20
+)
+
+var errRequestTooLarge = errors.New("Request body exceeds 8 MiB")
+
+// readMessage returns the SOAP envelope of the request. For MTOM requests
+// (multipart/related with an application/xop+xml root part) it also returns
+// the other MIME parts keyed by Content-ID, for resolveXOP.
+func readMessage(c *gin.Context) ([]byte, map[string][]byte, error) {
+	body, err := io.ReadAll(io.LimitReader(c.Request.Body, maxRequestSize+1))
+	if err != nil {
+		return nil, nil, errors.New("Could not read request body")
+	}
+	if len(body) > maxRequestSize {
+		return nil, nil, errRequestTooLarge
+	}
+	mediaType, params, err := mime.ParseMediaType(c.GetHeader("Content-Type"))
+	if err != nil || mediaType != multipartRelated {
+		return body, nil, nil
+	}
+	c.Set(mtomKey, true)
+
+	var root []byte
+	parts := make(map[string][]byte)
+	mr := multipart.NewReader(bytes.NewReader(body), params["boundary"])
+	for {
+		part, err := mr.NextPart()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, nil, errors.New("Invalid multipart/related message")
+		}
+		data, err := io.ReadAll(part)
+		if err == nil && strings.EqualFold(part.Header.Get("Content-Transfer-Encoding"), "base64") {
+			data, err = base64.StdEncoding.DecodeString(string(bytes.Join(bytes.Fields(data), nil)))
+		}
+		if err != nil {
+			return nil, nil, errors.New("Invalid multipart/related message")
+		}
+
+		cid := strings.Trim(part.Header.Get("Content-ID"), "<>")
+		// The root part is named by the start parameter, or is the first part.
+		if root == nil && (params["start"] == "" || strings.Trim(params["start"], "<>") == cid) {
+			if partType, _, _ := mime.ParseMediaType(part.Header.Get("Content-Type")); partType != xopMediaType {
+				return nil, nil, errors.New("MTOM root part must be " + xopMediaType)
+			}
+			root = data
+			continue
+		}
+		parts[cid] = data
+	}
+	if root == nil {
+		return nil, nil, errors.New("MTOM message has no root part")
+	}
+	return root, parts, nil
+}
+
+func isMTOM(c *gin.Context) bool {
+	return c.GetBool(mtomKey)
+}
+
+// resolveXOP replaces every xop:Include in tokens with the base64 content of
+// the attachment it references, so the body can be validated and decoded as
+// if the binary data had been sent inline.
+func resolveXOP(tokens []xml.Token, parts map[string][]byte) ([]xml.Token, error) {
+	resolved := make([]xml.Token, 0, len(tokens))
+	for i := 0; i < len(tokens); i++ {
+		start, ok := tokens[i].(xml.StartElement)
+		if !ok || start.Name.Space != xopNamespace || start.Name.Local != "Include" {
+			resolved = append(resolved, tokens[i])
+			continue
+		}
+
+		var href string
+		for _, a := range start.Attr {
+			if a.Name.Local == "href" {
+				href = a.Value
+			}
+		}
+		data, ok := attachmentFor(href, parts)
+		if !ok {
+			return nil, fmt.Errorf("xop:Include references unknown attachment %q", href)
+		}
+		resolved = append(resolved, xml.CharData(base64.StdEncoding.EncodeToString(data)))
+
+		// Skip to the end of the xop:Include element.
+		for depth := 1; depth > 0; {
+			i++
+			switch tokens[i].(type) {
+			case xml.StartElement:
+				depth++
+			case xml.EndElement:
+				depth--
+			}
+		}
+	}
+	return resolved, nil
+}
+
+// attachmentFor looks up the part a cid: URL refers to. The URL is
+// percent-encoded (RFC 2392) but some clients also escape the Content-ID
+// header, so both forms are tried.
+func attachmentFor(href string, parts map[string][]byte) ([]byte, bool) {
+	raw, ok := strings.CutPrefix(href, "cid:")
+	if !ok {
+		return nil, false
+	}
+	if cid, err := url.PathUnescape(raw); err == nil {
+		if data, ok := parts[cid]; ok {
+			return data, true
+		}
+	}
+	data, ok := parts[raw]
+	return data, ok
+}
+
+// binaryContent marshals as inline base64, or as an xop:Include when CID
+// names an MTOM attachment carrying Data.
+type binaryContent struct {
+	Data []byte
+	CID  string
+}
+
+func (b binaryContent) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if b.CID == "" {
+		return e.EncodeElement(base64.StdEncoding.EncodeToString(b.Data), start)
+	}
+	include := xml.StartElement{
+		Name: xml.Name{Local: "xop:Include"},
+		Attr: []xml.Attr{
+			{Name: xml.Name{Local: "xmlns:xop"}, Value: xopNamespace},
+			{Name: xml.Name{Local: "href"}, Value: "cid:" + url.PathEscape(b.CID)},
+		},
+	}
+	for _, tok := range []xml.Token{start, include, include.End(), start.End()} {
+		if err := e.EncodeToken(tok); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type attachment struct {
+	CID         string
+	ContentType string
+	Data        []byte
+}
+
+// writeMTOMResponse sends resp as the root part of a multipart/related
+// message followed by the attachments its xop:Include elements reference.
+func (h *MovieSOAPHandler) writeMTOMResponse(c *gin.Context, resp any, attachments ...attachment) {
+	out, _ := xml.Marshal(resp)
+	version := versionOf(c)
+
+	var buf bytes.Buffer
+	mw := multipart.NewWriter(&buf)
+	root := textproto.MIMEHeader{}
+	root.Set("Content-Type", mime.FormatMediaType(xopMediaType, map[string]string{"charset": "utf-8", "type": version.mediaType()}))
+	root.Set("Content-Transfer-Encoding", "8bit")
+	root.Set("Content-ID", "<"+mtomRootCID+">")
+	w, _ := mw.CreatePart(root)
+	w.Write(envelopeBytes(version, string(out)))
+
+	for _, a := range attachments {
+		header := textproto.MIMEHeader{}
+		header.Set("Content-Type", a.ContentType)
+		header.Set("Content-Transfer-Encoding", "binary")
+		header.Set("Content-ID", "<"+a.CID+">")
+		w, _ := mw.CreatePart(header)
+		w.Write(a.Data)
+	}
+	mw.Close()
+
+	c.Data(http.StatusOK, mime.FormatMediaType(multipartRelated, map[string]string{
+		"type":       xopMediaType,
+		"boundary":   mw.Boundary(),
+		"start":      "<" + mtomRootCID + ">",
+		"start-info": version.mediaType(),
+	}), buf.Bytes())
+}
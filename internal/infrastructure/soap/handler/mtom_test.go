@@ -0,0 +1,58 @@
+package soaphandler
+
+import (
+	"encoding/base64"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadMessage_mtom(t *testing.T) {
+	body := "--b\r\n" +
+		"Content-Type: application/xop+xml; type=\"text/xml\"\r\nContent-ID: <root>\r\n\r\n<Envelope/>\r\n" +
+		"--b\r\n" +
+		"Content-Type: image/png\r\nContent-ID: <poster 1@client>\r\n\r\n\x89PNG\r\n" +
+		"--b\r\n" +
+		"Content-Type: image/png\r\nContent-Transfer-Encoding: base64\r\nContent-ID: <encoded@client>\r\n\r\naGVs\r\nbG8=\r\n" +
+		"--b--\r\n"
+	c, _ := gin.CreateTestContext(httptest.NewRecorder())
+	c.Request = httptest.NewRequest("POST", "/soap/movie", strings.NewReader(body))
+	c.Request.Header.Set("Content-Type", `multipart/related; type="application/xop+xml"; boundary=b; start="<root>"`)
+
+	envelope, parts, err := readMessage(c)
+	require.NoError(t, err)
+	assert.Equal(t, "<Envelope/>", string(envelope))
+	assert.Equal(t, map[string][]byte{"poster 1@client": []byte("\x89PNG"), "encoded@client": []byte("hello")}, parts)
+	assert.True(t, isMTOM(c))
+}
+
+func TestReadMessage_rootNotXOP(t *testing.T) {
+	c, _ := gin.CreateTestContext(httptest.NewRecorder())
+	c.Request = httptest.NewRequest("POST", "/soap/movie", strings.NewReader("--b\r\nContent-Type: text/xml\r\n\r\n<Envelope/>\r\n--b--\r\n"))
+	c.Request.Header.Set("Content-Type", "multipart/related; boundary=b")
+
+	_, _, err := readMessage(c)
+	assert.EqualError(t, err, "MTOM root part must be application/xop+xml")
+}
+
+func TestResolveXOP(t *testing.T) {
+	body, err := readBodyElement([]byte(`<s:Envelope xmlns:s="http://schemas.xmlsoap.org/soap/envelope/"><s:Body>` +
+		`<UploadPosterRequest><movieId>1</movieId><image><xop:Include xmlns:xop="http://www.w3.org/2004/08/xop/include" href="cid:poster%201@client"/></image></UploadPosterRequest>` +
+		`</s:Body></s:Envelope>`))
+	require.NoError(t, err)
+
+	resolved, err := resolveXOP(body, map[string][]byte{"poster 1@client": []byte("\x89PNG")})
+	require.NoError(t, err)
+	var req struct {
+		Image string `xml:"image"`
+	}
+	require.NoError(t, replay(resolved).Decode(&req))
+	assert.Equal(t, base64.StdEncoding.EncodeToString([]byte("\x89PNG")), req.Image)
+
+	_, err = resolveXOP(body, nil)
+	assert.EqualError(t, err, `xop:Include references unknown attachment "cid:poster%201@client"`)
+}
@@ -0,0 +1,64 @@
+package soaphandler
+
+import (
+	"encoding/xml"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	movieservicebinding "github.com/sorrawichYooboon/go-protocol-api-style/internal/infrastructure/soap/gen"
+	"github.com/sorrawichYooboon/go-protocol-api-style/internal/infrastructure/soap/wsdl"
+)
+
+func (h *MovieSOAPHandler) processUploadPoster(c *gin.Context, req movieservicebinding.UploadPosterRequest) {
+	// The generated type keeps base64Binary content undecoded.
+	data, err := wsdl.DecodeBase64Binary(string(*req.Image))
+	if err != nil {
+		h.writeSOAPFault(c, faultCodeClient, "Invalid UploadPosterRequest")
+		return
+	}
+	poster, err := h.posterUsecase.UploadPoster(c.Request.Context(), *req.MovieId, data)
+	if err != nil {
+		h.writeSOAPError(c, err)
+		return
+	}
+
+	size := int64(len(poster.Data))
+	h.writeSOAPResponse(c, movieservicebinding.UploadPosterResponse{
+		MovieId:     &poster.MovieID,
+		ContentType: &poster.ContentType,
+		Size:        &size,
+	})
+}
+
+// getPosterResponse replaces the generated GetPosterResponse, whose image
+// field would be marshalled as raw bytes rather than base64 or xop:Include.
+type getPosterResponse struct {
+	XMLName     xml.Name      `xml:"GetPosterResponse"`
+	MovieID     int64         `xml:"movieId"`
+	ContentType string        `xml:"contentType"`
+	Size        int64         `xml:"size"`
+	Image       binaryContent `xml:"image"`
+}
+
+// processGetPoster answers MTOM requests with the image as an attachment and
+// all others with the image inline.
+func (h *MovieSOAPHandler) processGetPoster(c *gin.Context, req movieservicebinding.GetPosterRequest) {
+	poster, err := h.posterUsecase.GetPoster(c.Request.Context(), *req.MovieId)
+	if err != nil {
+		h.writeSOAPError(c, err)
+		return
+	}
+
+	resp := getPosterResponse{
+		MovieID:     poster.MovieID,
+		ContentType: poster.ContentType,
+		Size:        int64(len(poster.Data)),
+		Image:       binaryContent{Data: poster.Data},
+	}
+	if !isMTOM(c) {
+		h.writeSOAPResponse(c, resp)
+		return
+	}
+	resp.Image.CID = "poster-" + strconv.FormatInt(poster.MovieID, 10) + "@moviesoap"
+	h.writeMTOMResponse(c, resp, attachment{CID: resp.Image.CID, ContentType: poster.ContentType, Data: poster.Data})
+}
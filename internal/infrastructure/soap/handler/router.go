@@ -0,0 +1,222 @@
+package soaphandler
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	movieservicebinding "github.com/sorrawichYooboon/go-protocol-api-style/internal/infrastructure/soap/gen"
+	"github.com/sorrawichYooboon/go-protocol-api-style/internal/infrastructure/soap/wsdl"
+)
+
+// operationHandler decodes the request element from d and serves it.
+type operationHandler func(h *MovieSOAPHandler, c *gin.Context, d *xml.Decoder)
+
+func decodeOperation[T any](process func(*MovieSOAPHandler, *gin.Context, T)) operationHandler {
+	return func(h *MovieSOAPHandler, c *gin.Context, d *xml.Decoder) {
+		var req T
+		if err := d.Decode(&req); err != nil {
+			h.writeSOAPFault(c, faultCodeClient, "Invalid request body")
+			return
+		}
+		process(h, c, req)
+	}
+}
+
+var movieOperationHandlers = map[string]operationHandler{
+	"GetMovie":     decodeOperation[movieservicebinding.GetMovieRequest]((*MovieSOAPHandler).processGetMovie),
+	"ListMovies":   decodeOperation[movieservicebinding.ListMoviesRequest]((*MovieSOAPHandler).processListMovies),
+	"SearchMovies": decodeOperation[movieservicebinding.SearchMoviesRequest]((*MovieSOAPHandler).processSearchMovies),
+	"CreateMovie":  decodeOperation[movieservicebinding.CreateMovieRequest]((*MovieSOAPHandler).processCreateMovie),
+	"UpdateMovie":  decodeOperation[movieservicebinding.UpdateMovieRequest]((*MovieSOAPHandler).processUpdateMovie),
+	"DeleteMovie":  decodeOperation[movieservicebinding.DeleteMovieRequest]((*MovieSOAPHandler).processDeleteMovie),
+	"UploadPoster": decodeOperation[movieservicebinding.UploadPosterRequest]((*MovieSOAPHandler).processUploadPoster),
+	"GetPoster":    decodeOperation[movieservicebinding.GetPosterRequest]((*MovieSOAPHandler).processGetPoster),
+}
+
+var movieRouter = newOperationRouter(wsdl.MovieService, movieOperationHandlers)
+
+type operationRoute struct {
+	op     wsdl.Operation
+	handle operationHandler
+}
+
+// operationRouter finds the operation for a request from the qualified name
+// of its body element and the SOAP action. The body element is either the
+// request element, e.g. tns:GetMovieRequest, or an operation wrapper around
+// it, e.g. tns:GetMovie as sent by the generated client.
+type operationRouter struct {
+	namespace string
+	byRequest map[string]*operationRoute
+	byWrapper map[string]*operationRoute
+	byAction  map[string]*operationRoute
+}
+
+func newOperationRouter(service *wsdl.Service, handlers map[string]operationHandler) *operationRouter {
+	r := &operationRouter{
+		namespace: service.Namespace,
+		byRequest: make(map[string]*operationRoute),
+		byWrapper: make(map[string]*operationRoute),
+		byAction:  make(map[string]*operationRoute),
+	}
+	for _, op := range service.Operations {
+		handle, ok := handlers[op.Name]
+		if !ok {
+			panic("soap: no handler for operation " + op.Name)
+		}
+		route := &operationRoute{op: op, handle: handle}
+		r.byRequest[op.Request.Name] = route
+		r.byWrapper[op.Name] = route
+		r.byAction[op.SOAPAction] = route
+		r.byAction[service.Namespace+"/"+op.SOAPAction] = route
+	}
+	return r
+}
+
+// route returns the operation and the tokens of its request element. An
+// empty action matches any operation.
+func (r *operationRouter) route(action string, body []xml.Token) (*operationRoute, []xml.Token, error) {
+	start := body[0].(xml.StartElement)
+	if start.Name.Space != "" && start.Name.Space != r.namespace {
+		return nil, nil, fmt.Errorf("Unknown body element {%s}%s", start.Name.Space, start.Name.Local)
+	}
+
+	route, ok := r.byRequest[start.Name.Local]
+	if !ok {
+		// Wrappers are only defined in the target namespace.
+		if route, ok = r.byWrapper[start.Name.Local]; !ok || start.Name.Space != r.namespace {
+			return nil, nil, fmt.Errorf("Unknown body element %s", start.Name.Local)
+		}
+		body = firstChild(body)
+		if body == nil || !r.isRequest(body[0].(xml.StartElement).Name, route.op) {
+			return nil, nil, fmt.Errorf("%s must contain %s", start.Name.Local, route.op.Request.Name)
+		}
+	}
+
+	if action != "" {
+		byAction, ok := r.byAction[action]
+		if !ok {
+			return nil, nil, fmt.Errorf("Unknown SOAP action %q", action)
+		}
+		if byAction != route {
+			return nil, nil, fmt.Errorf("SOAP action %q does not match body element %s", action, start.Name.Local)
+		}
+	}
+	return route, body, nil
+}
+
+func (r *operationRouter) isRequest(name xml.Name, op wsdl.Operation) bool {
+	return name.Local == op.Request.Name && (name.Space == "" || name.Space == r.namespace)
+}
+
+// requestAction reads the SOAPAction header for SOAP 1.1, or the action
+// parameter of the Content-Type for SOAP 1.2.
+func requestAction(c *gin.Context) string {
+	if versionOf(c) == soap12 {
+		_, params := soapContentType(c)
+		return params["action"]
+	}
+	return strings.Trim(strings.TrimSpace(c.GetHeader("SOAPAction")), `"`)
+}
+
+var errNoBodyElement = errors.New("SOAP Body must contain an element")
+
+// readBodyElement returns the tokens of the first element in the envelope's
+// Body. Names in the tokens are already resolved to namespace URIs, so they
+// can be replayed without the declarations in scope on the envelope.
+func readBodyElement(data []byte) ([]xml.Token, error) {
+	d := xml.NewDecoder(bytes.NewReader(data))
+	// The Envelope is at depth 1, Header and Body at 2.
+	depth := 0
+	inBody := false
+	var tokens []xml.Token
+	for {
+		tok, err := d.Token()
+		if err == io.EOF {
+			return nil, errNoBodyElement
+		}
+		if err != nil {
+			return nil, err
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			depth++
+			if depth == 2 {
+				inBody = t.Name.Local == "Body"
+			}
+			if inBody && depth > 2 {
+				tokens = append(tokens, withoutNamespaceAttrs(t))
+			}
+		case xml.EndElement:
+			if inBody && depth > 2 {
+				tokens = append(tokens, t)
+			}
+			depth--
+			if inBody && depth == 2 {
+				return tokens, nil
+			}
+			if inBody && depth == 1 {
+				return nil, errNoBodyElement
+			}
+		default:
+			if inBody && depth > 2 {
+				tokens = append(tokens, xml.CopyToken(tok))
+			}
+		}
+	}
+}
+
+func withoutNamespaceAttrs(start xml.StartElement) xml.StartElement {
+	attrs := make([]xml.Attr, 0, len(start.Attr))
+	for _, a := range start.Attr {
+		if a.Name.Space == "xmlns" || (a.Name.Space == "" && a.Name.Local == "xmlns") {
+			continue
+		}
+		attrs = append(attrs, a)
+	}
+	start.Attr = attrs
+	return start
+}
+
+// firstChild returns the tokens of the first child element of the element in
+// tokens, or nil if it has none.
+func firstChild(tokens []xml.Token) []xml.Token {
+	depth := 0
+	begin := -1
+	for i, tok := range tokens[1:] {
+		switch tok.(type) {
+		case xml.StartElement:
+			if depth == 0 {
+				begin = i + 1
+			}
+			depth++
+		case xml.EndElement:
+			depth--
+			if depth == 0 && begin >= 0 {
+				return tokens[begin : i+2]
+			}
+		}
+	}
+	return nil
+}
+
+type tokenReader struct {
+	tokens []xml.Token
+}
+
+func (r *tokenReader) Token() (xml.Token, error) {
+	if len(r.tokens) == 0 {
+		return nil, io.EOF
+	}
+	tok := r.tokens[0]
+	r.tokens = r.tokens[1:]
+	return tok, nil
+}
+
+func replay(tokens []xml.Token) *xml.Decoder {
+	return xml.NewTokenDecoder(&tokenReader{tokens: tokens})
+}
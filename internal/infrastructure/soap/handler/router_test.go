@@ -0,0 +1,54 @@
+package soaphandler
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOperationRouter_route(t *testing.T) {
+	envelope := func(body string) []byte {
+		return []byte(`<s:Envelope xmlns:s="http://schemas.xmlsoap.org/soap/envelope/" xmlns:tns="http://example.com/moviesoap"><s:Body>` + body + `</s:Body></s:Envelope>`)
+	}
+
+	tests := []struct {
+		name    string
+		action  string
+		body    string
+		wantOp  string
+		wantErr string
+	}{
+		{name: "unqualified request element", body: `<GetMovieRequest><id>1</id></GetMovieRequest>`, wantOp: "GetMovie"},
+		{name: "qualified request element", body: `<tns:DeleteMovieRequest><id>1</id></tns:DeleteMovieRequest>`, wantOp: "DeleteMovie"},
+		{name: "operation wrapper", body: `<tns:ListMovies><ListMoviesRequest/></tns:ListMovies>`, wantOp: "ListMovies"},
+		{name: "SOAPAction", action: "GetMovie", body: `<GetMovieRequest><id>1</id></GetMovieRequest>`, wantOp: "GetMovie"},
+		{name: "namespaced SOAPAction", action: "http://example.com/moviesoap/GetMovie", body: `<GetMovieRequest><id>1</id></GetMovieRequest>`, wantOp: "GetMovie"},
+		{name: "mismatched SOAPAction", action: "DeleteMovie", body: `<GetMovieRequest><id>1</id></GetMovieRequest>`, wantErr: `SOAP action "DeleteMovie" does not match body element GetMovieRequest`},
+		{name: "unknown SOAPAction", action: "Nope", body: `<GetMovieRequest><id>1</id></GetMovieRequest>`, wantErr: `Unknown SOAP action "Nope"`},
+		{name: "foreign namespace", body: `<x:GetMovieRequest xmlns:x="urn:x"><id>1</id></x:GetMovieRequest>`, wantErr: "Unknown body element {urn:x}GetMovieRequest"},
+		{name: "wrapper around the wrong request", body: `<tns:GetMovie><DeleteMovieRequest><id>1</id></DeleteMovieRequest></tns:GetMovie>`, wantErr: "GetMovie must contain GetMovieRequest"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body, err := readBodyElement(envelope(tt.body))
+			require.NoError(t, err)
+
+			route, request, err := movieRouter.route(tt.action, body)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantOp, route.op.Name)
+			assert.True(t, movieRouter.isRequest(request[0].(xml.StartElement).Name, route.op))
+		})
+	}
+}
+
+func TestReadBodyElement_empty(t *testing.T) {
+	_, err := readBodyElement([]byte(`<s:Envelope xmlns:s="http://schemas.xmlsoap.org/soap/envelope/"><s:Header><x/></s:Header><s:Body> </s:Body></s:Envelope>`))
+	assert.Equal(t, errNoBodyElement, err)
+}
@@ -0,0 +1,30 @@
+package soaphandler
+
+import (
+	"encoding/xml"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sorrawichYooboon/go-protocol-api-style/internal/infrastructure/soap/wsdl"
+	"github.com/sorrawichYooboon/go-protocol-api-style/internal/usecase"
+)
+
+// validate checks the operation's body element against the XSD published in
+// the WSDL and writes a Client fault listing every violation when it does not
+// match.
+func (h *MovieSOAPHandler) validate(c *gin.Context, op wsdl.Operation, request []xml.Token) bool {
+	violations, err := wsdl.MovieService.Validate(op.Request, replay(request))
+	if err != nil {
+		h.writeSOAPFault(c, faultCodeClient, "Invalid "+op.Request.Name)
+		return false
+	}
+	if len(violations) == 0 {
+		return true
+	}
+
+	fieldViolations := make([]usecase.FieldViolation, 0, len(violations))
+	for _, v := range violations {
+		fieldViolations = append(fieldViolations, usecase.FieldViolation{Field: v.Path, Description: v.Description})
+	}
+	h.writeSOAPError(c, usecase.NewInvalidArgumentError(op.Request.Name+" does not match the schema", fieldViolations...))
+	return false
+}
@@ -0,0 +1,87 @@
+package soaphandler
+
+import (
+	"mime"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	soap11Namespace = "http://schemas.xmlsoap.org/soap/envelope/"
+	soap12Namespace = "http://www.w3.org/2003/05/soap-envelope"
+
+	soap11MediaType = "text/xml"
+	soap12MediaType = "application/soap+xml"
+
+	soapVersionKey = "soap.version"
+)
+
+type soapVersion int
+
+const (
+	soap11 soapVersion = iota
+	soap12
+)
+
+func (v soapVersion) namespace() string {
+	if v == soap12 {
+		return soap12Namespace
+	}
+	return soap11Namespace
+}
+
+func (v soapVersion) mediaType() string {
+	if v == soap12 {
+		return soap12MediaType
+	}
+	return soap11MediaType
+}
+
+func (v soapVersion) contentType() string {
+	return v.mediaType() + "; charset=utf-8"
+}
+
+// envelopeVersion maps the namespace of the request's Envelope element to a
+// SOAP version.
+func envelopeVersion(namespace string) (soapVersion, bool) {
+	switch namespace {
+	case soap11Namespace:
+		return soap11, true
+	case soap12Namespace:
+		return soap12, true
+	}
+	return soap11, false
+}
+
+// versionOf returns the SOAP version of the request. Until the envelope has
+// been parsed, e.g. when middleware rejects the request, it is inferred from
+// the Content-Type.
+func versionOf(c *gin.Context) soapVersion {
+	if v, ok := c.Get(soapVersionKey); ok {
+		return v.(soapVersion)
+	}
+	if mediaType, _ := soapContentType(c); mediaType == soap12MediaType {
+		return soap12
+	}
+	return soap11
+}
+
+// soapContentType returns the media type and parameters describing the
+// envelope. For MTOM they come from the start-info parameter.
+func soapContentType(c *gin.Context) (string, map[string]string) {
+	mediaType, params, err := mime.ParseMediaType(c.GetHeader("Content-Type"))
+	if err != nil {
+		return "", nil
+	}
+	if mediaType == multipartRelated {
+		startType, startParams, err := mime.ParseMediaType(params["start-info"])
+		if err != nil {
+			startParams = make(map[string]string)
+		}
+		if params["action"] != "" {
+			startParams["action"] = params["action"]
+		}
+		return startType, startParams
+	}
+	return mediaType, params
+}
@@ -5,7 +5,8 @@ import (
 	soaphandler "github.com/sorrawichYooboon/go-protocol-api-style/internal/infrastructure/soap/handler"
 )
 
-func SetupSOAPRoutes(router *gin.Engine, movieHandler *soaphandler.MovieSOAPHandler) {
-	router.GET("/soap/movie.wsdl", movieHandler.ServeWSDL)
-	router.POST("/soap/movie", movieHandler.Handle)
+func SetupSOAPRoutes(router *gin.Engine, movieHandler *soaphandler.MovieSOAPHandler, middleware ...gin.HandlerFunc) {
+	soap := router.Group("/soap", middleware...)
+	soap.GET("/movie.wsdl", movieHandler.ServeWSDL)
+	soap.POST("/movie", movieHandler.Handle)
 }
@@ -0,0 +1,120 @@
+package wsdl
+
+var movieFields = []Field{
+	{Name: "id", Type: "xsd:long"},
+	{Name: "title", Type: "xsd:string"},
+	{Name: "description", Type: "xsd:string"},
+	// Omitted when no release date is stored for the movie.
+	{Name: "releaseDate", Type: "xsd:date", Optional: true},
+}
+
+var pageFields = []Field{
+	{Name: "pageSize", Type: "xsd:int", Optional: true},
+	{Name: "pageToken", Type: "xsd:string", Optional: true},
+}
+
+var movieListFields = []Field{
+	{Name: "movie", Type: "tns:Movie", Optional: true, Repeated: true},
+	{Name: "nextPageToken", Type: "xsd:string", Optional: true},
+}
+
+var posterInfoFields = []Field{
+	{Name: "movieId", Type: "xsd:long"},
+	{Name: "contentType", Type: "xsd:string"},
+	{Name: "size", Type: "xsd:long"},
+}
+
+// MovieService is the registry of SOAP operations served at /soap/movie.
+// The WSDL is rendered from it; regenerate internal/infrastructure/soap/gen
+// after changing it (see make gen-soap).
+var MovieService = &Service{
+	Name:          "MovieService",
+	Documentation: "Movie SOAP Service",
+	Namespace:     "http://example.com/moviesoap",
+	Types: []ComplexType{
+		{Name: "Movie", Fields: movieFields},
+		{Name: "FieldViolation", Fields: []Field{
+			{Name: "field", Type: "xsd:string"},
+			{Name: "description", Type: "xsd:string"},
+		}},
+	},
+	Header: Element{Name: "APIKey", Type: "xsd:string"},
+	Fault: Element{Name: "MovieFault", Fields: []Field{
+		{Name: "code", Type: "xsd:string"},
+		{Name: "message", Type: "xsd:string"},
+		{Name: "violation", Type: "tns:FieldViolation", Optional: true, Repeated: true},
+	}},
+	Operations: []Operation{
+		{
+			Name:       "GetMovie",
+			SOAPAction: "GetMovie",
+			Request:    Element{Name: "GetMovieRequest", Fields: []Field{{Name: "id", Type: "xsd:long"}}},
+			Response:   Element{Name: "GetMovieResponse", Fields: movieFields},
+		},
+		{
+			Name:       "CreateMovie",
+			SOAPAction: "CreateMovie",
+			Request: Element{Name: "CreateMovieRequest", Fields: []Field{
+				{Name: "title", Type: "xsd:string"},
+				{Name: "description", Type: "xsd:string", Optional: true},
+				{Name: "releaseDate", Type: "xsd:date"},
+			}},
+			Response: Element{Name: "CreateMovieResponse", Fields: movieFields},
+		},
+		{
+			Name:       "UpdateMovie",
+			SOAPAction: "UpdateMovie",
+			Request: Element{Name: "UpdateMovieRequest", Fields: []Field{
+				{Name: "id", Type: "xsd:long"},
+				{Name: "title", Type: "xsd:string"},
+				{Name: "description", Type: "xsd:string", Optional: true},
+				{Name: "releaseDate", Type: "xsd:date"},
+			}},
+			Response: Element{Name: "UpdateMovieResponse", Fields: movieFields},
+		},
+		{
+			Name:       "DeleteMovie",
+			SOAPAction: "DeleteMovie",
+			Request:    Element{Name: "DeleteMovieRequest", Fields: []Field{{Name: "id", Type: "xsd:long"}}},
+			Response:   Element{Name: "DeleteMovieResponse", Fields: movieFields},
+		},
+		{
+			Name:       "ListMovies",
+			SOAPAction: "ListMovies",
+			Request: Element{Name: "ListMoviesRequest", Fields: append(pageFields[:len(pageFields):len(pageFields)],
+				Field{Name: "titleContains", Type: "xsd:string", Optional: true},
+				Field{Name: "releasedFrom", Type: "xsd:date", Optional: true},
+				Field{Name: "releasedTo", Type: "xsd:date", Optional: true},
+				// One of id, title or releaseDate. Defaults to id.
+				Field{Name: "sortBy", Type: "xsd:string", Optional: true},
+				Field{Name: "descending", Type: "xsd:boolean", Optional: true},
+			)},
+			Response: Element{Name: "ListMoviesResponse", Fields: movieListFields},
+		},
+		{
+			Name:       "SearchMovies",
+			SOAPAction: "SearchMovies",
+			Request: Element{Name: "SearchMoviesRequest", Fields: append([]Field{
+				{Name: "query", Type: "xsd:string"},
+			}, pageFields...)},
+			Response: Element{Name: "SearchMoviesResponse", Fields: movieListFields},
+		},
+		{
+			Name:       "UploadPoster",
+			SOAPAction: "UploadPoster",
+			Request: Element{Name: "UploadPosterRequest", Fields: []Field{
+				{Name: "movieId", Type: "xsd:long"},
+				{Name: "image", Type: "xsd:base64Binary"},
+			}},
+			Response: Element{Name: "UploadPosterResponse", Fields: posterInfoFields},
+		},
+		{
+			Name:       "GetPoster",
+			SOAPAction: "GetPoster",
+			Request:    Element{Name: "GetPosterRequest", Fields: []Field{{Name: "movieId", Type: "xsd:long"}}},
+			Response: Element{Name: "GetPosterResponse", Fields: append(posterInfoFields[:len(posterInfoFields):len(posterInfoFields)],
+				Field{Name: "image", Type: "xsd:base64Binary"},
+			)},
+		},
+	},
+}
@@ -0,0 +1,96 @@
+package wsdl
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/xml"
+	"io"
+	"text/template"
+)
+
+// Field is an element inside an xsd:sequence. Type is a qualified XSD type
+// such as "xsd:long" or "tns:Movie".
+type Field struct {
+	Name     string
+	Type     string
+	Optional bool
+	Repeated bool
+}
+
+// ComplexType is a named xsd:complexType.
+type ComplexType struct {
+	Name   string
+	Fields []Field
+}
+
+// Element is a global schema element. A simple element has Type set; any
+// other element is an anonymous complexType made of Fields.
+type Element struct {
+	Name   string
+	Type   string
+	Fields []Field
+}
+
+// Operation is a document/literal operation. Request and Response are the
+// body elements of its input and output messages.
+type Operation struct {
+	Name       string
+	SOAPAction string
+	Request    Element
+	Response   Element
+}
+
+// Service describes everything the WSDL publishes. Header is the optional
+// SOAP header every input accepts and Fault the detail of every fault.
+type Service struct {
+	Name          string
+	Documentation string
+	Namespace     string
+	Types         []ComplexType
+	Header        Element
+	Fault         Element
+	Operations    []Operation
+}
+
+// Elements returns the global elements in the order they appear in the schema.
+func (s *Service) Elements() []Element {
+	elements := []Element{s.Header, s.Fault}
+	for _, op := range s.Operations {
+		elements = append(elements, op.Request, op.Response)
+	}
+	return elements
+}
+
+type binding struct {
+	Name    string
+	Port    string
+	Prefix  string
+	Service *Service
+}
+
+//go:embed service.wsdl.tmpl
+var wsdlTemplate string
+
+var tmpl = template.Must(template.New("wsdl").Parse(wsdlTemplate))
+
+// Render writes the WSDL with both SOAP bindings bound to address.
+func (s *Service) Render(w io.Writer, address string) error {
+	var escaped bytes.Buffer
+	if err := xml.EscapeText(&escaped, []byte(address)); err != nil {
+		return err
+	}
+	return tmpl.Execute(w, struct {
+		*Service
+		Address  string
+		Bindings []binding
+	}{
+		Service: s,
+		Address: escaped.String(),
+		// wsdl2go generates the client from the last binding, so SOAP 1.1
+		// comes last.
+		Bindings: []binding{
+			{Name: s.Name + "Soap12Binding", Port: s.Name + "Soap12Port", Prefix: "soap12", Service: s},
+			{Name: s.Name + "Binding", Port: s.Name + "Port", Prefix: "soap", Service: s},
+		},
+	})
+}
@@ -0,0 +1,47 @@
+package wsdl
+
+import (
+	"bytes"
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type definitions struct {
+	Bindings []struct {
+		Name       string `xml:"name,attr"`
+		Operations []struct {
+			Name string `xml:"name,attr"`
+		} `xml:"operation"`
+	} `xml:"binding"`
+	Ports []struct {
+		Binding string `xml:"binding,attr"`
+		Address struct {
+			Location string `xml:"location,attr"`
+		} `xml:"address"`
+	} `xml:"service>port"`
+}
+
+func TestService_Render(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, MovieService.Render(&buf, "https://api.example.com/soap/movie?a=1&b=2"))
+
+	var defs definitions
+	require.NoError(t, xml.Unmarshal(buf.Bytes(), &defs))
+
+	require.Len(t, defs.Bindings, 2)
+	assert.Equal(t, "MovieServiceBinding", defs.Bindings[1].Name, "wsdl2go reads the last binding")
+	for _, b := range defs.Bindings {
+		require.Len(t, b.Operations, len(MovieService.Operations))
+		for i, op := range MovieService.Operations {
+			assert.Equal(t, op.Name, b.Operations[i].Name)
+		}
+	}
+
+	require.Len(t, defs.Ports, 2)
+	for _, p := range defs.Ports {
+		assert.Equal(t, "https://api.example.com/soap/movie?a=1&b=2", p.Address.Location)
+	}
+}
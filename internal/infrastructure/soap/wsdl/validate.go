@@ -0,0 +1,240 @@
+package wsdl
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sorrawichYooboon/go-protocol-api-style/internal/domain"
+)
+
+// Violation is a schema violation at Path, e.g. "GetMovieRequest/id".
+type Violation struct {
+	Path        string
+	Description string
+}
+
+// Validate checks the next element read from d against its schema
+// declaration. Local
+// elements are unqualified in the schema, but children in the target
+// namespace are accepted too since many clients put a default xmlns on the
+// body element.
+func (s *Service) Validate(element Element, d *xml.Decoder) ([]Violation, error) {
+	for {
+		tok, err := d.Token()
+		if err != nil {
+			return nil, err
+		}
+		if start, ok := tok.(xml.StartElement); ok {
+			v := &validator{service: s, decoder: d}
+			if start.Name.Local != element.Name {
+				v.add(start.Name.Local, "must be "+element.Name)
+				return v.violations, nil
+			}
+			if err := v.element(element.Name, element.Type, element.Fields); err != nil {
+				return nil, err
+			}
+			return v.violations, nil
+		}
+	}
+}
+
+type validator struct {
+	service    *Service
+	decoder    *xml.Decoder
+	violations []Violation
+}
+
+func (v *validator) add(path, description string) {
+	v.violations = append(v.violations, Violation{Path: path, Description: description})
+}
+
+// element validates the content of an element whose start tag has just been
+// read, consuming everything up to and including its end tag.
+func (v *validator) element(path, typ string, fields []Field) error {
+	if typ != "" {
+		if ct, ok := v.service.complexType(typ); ok {
+			fields = ct.Fields
+		} else {
+			return v.simple(path, typ)
+		}
+	}
+
+	i, count := 0, 0
+	for {
+		tok, err := v.decoder.Token()
+		if err != nil {
+			return unexpectedEOF(err)
+		}
+		switch t := tok.(type) {
+		case xml.EndElement:
+			for ; i < len(fields); i, count = i+1, 0 {
+				if count == 0 && !fields[i].Optional {
+					v.add(path+"/"+fields[i].Name, "is required")
+				}
+			}
+			return nil
+		case xml.CharData:
+			if len(bytes.TrimSpace(t)) > 0 {
+				v.add(path, "must not contain text")
+			}
+		case xml.StartElement:
+			name := t.Name.Local
+			childPath := path + "/" + name
+			if t.Name.Space != "" && t.Name.Space != v.service.Namespace {
+				v.add(childPath, "is not in the schema namespace")
+				if err := v.decoder.Skip(); err != nil {
+					return unexpectedEOF(err)
+				}
+				continue
+			}
+			// Advance through the sequence, reporting required fields the
+			// element skipped over.
+			j := len(fields)
+			if k := indexOf(fields[i:], name); k >= 0 {
+				j = i + k
+			}
+			if j == len(fields) {
+				desc := "is not allowed here"
+				if indexOf(fields[:i], name) >= 0 {
+					desc = "is out of order"
+				}
+				v.add(childPath, desc)
+				if err := v.decoder.Skip(); err != nil {
+					return unexpectedEOF(err)
+				}
+				continue
+			}
+			if j == i {
+				if count > 0 && !fields[i].Repeated {
+					v.add(childPath, "must not be repeated")
+				}
+			} else {
+				for k := i; k < j; k++ {
+					if (k > i || count == 0) && !fields[k].Optional {
+						v.add(path+"/"+fields[k].Name, "is required")
+					}
+				}
+				i, count = j, 0
+			}
+			count++
+			if err := v.element(childPath, fields[i].Type, nil); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (v *validator) simple(path, typ string) error {
+	var text strings.Builder
+	for {
+		tok, err := v.decoder.Token()
+		if err != nil {
+			return unexpectedEOF(err)
+		}
+		switch t := tok.(type) {
+		case xml.EndElement:
+			if desc := checkSimple(typ, strings.TrimSpace(text.String())); desc != "" {
+				v.add(path, desc)
+			}
+			return nil
+		case xml.CharData:
+			text.Write(t)
+		case xml.StartElement:
+			v.add(path+"/"+t.Name.Local, "is not allowed here")
+			if err := v.decoder.Skip(); err != nil {
+				return unexpectedEOF(err)
+			}
+		}
+	}
+}
+
+func checkSimple(typ, value string) string {
+	switch typ {
+	case "xsd:long":
+		if _, err := strconv.ParseInt(value, 10, 64); err != nil {
+			return "must be an xsd:long"
+		}
+	case "xsd:int":
+		if _, err := strconv.ParseInt(value, 10, 32); err != nil {
+			return "must be an xsd:int"
+		}
+	case "xsd:base64Binary":
+		if _, err := DecodeBase64Binary(value); err != nil {
+			return "must be an xsd:base64Binary"
+		}
+	case "xsd:date":
+		if _, err := ParseDate(value); err != nil {
+			return "must be an xsd:date"
+		}
+	case "xsd:boolean":
+		switch value {
+		case "true", "false", "1", "0":
+		default:
+			return "must be an xsd:boolean"
+		}
+	case "xsd:string":
+	default:
+		return fmt.Sprintf("has unsupported type %s", typ)
+	}
+	return ""
+}
+
+func (s *Service) complexType(typ string) (ComplexType, bool) {
+	name, ok := strings.CutPrefix(typ, "tns:")
+	if !ok {
+		return ComplexType{}, false
+	}
+	for _, ct := range s.Types {
+		if ct.Name == name {
+			return ct, true
+		}
+	}
+	return ComplexType{}, false
+}
+
+// DecodeBase64Binary decodes xsd:base64Binary content, which may be wrapped
+// over several lines.
+func DecodeBase64Binary(s string) ([]byte, error) {
+	return base64.StdEncoding.DecodeString(strings.Map(func(r rune) rune {
+		if r == ' ' || r == '\t' || r == '\n' || r == '\r' {
+			return -1
+		}
+		return r
+	}, s))
+}
+
+// ParseDate parses xsd:date content. XSD allows a time zone suffix, which
+// means nothing for a calendar date and is ignored.
+func ParseDate(s string) (domain.Date, error) {
+	if len(s) > len(domain.DateLayout) {
+		if zone := s[len(domain.DateLayout):]; zone != "Z" {
+			if _, err := time.Parse("-07:00", zone); err != nil {
+				return domain.Date{}, fmt.Errorf("invalid time zone %q", zone)
+			}
+		}
+		s = s[:len(domain.DateLayout)]
+	}
+	return domain.ParseDate(s)
+}
+
+func indexOf(fields []Field, name string) int {
+	for i, f := range fields {
+		if f.Name == name {
+			return i
+		}
+	}
+	return -1
+}
+
+func unexpectedEOF(err error) error {
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
@@ -0,0 +1,107 @@
+package wsdl
+
+import (
+	"encoding/xml"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func operationNamed(t *testing.T, name string) Operation {
+	t.Helper()
+	for _, op := range MovieService.Operations {
+		if op.Name == name {
+			return op
+		}
+	}
+	t.Fatalf("no operation %s", name)
+	return Operation{}
+}
+
+func TestService_Validate(t *testing.T) {
+	updateMovie := operationNamed(t, "UpdateMovie")
+	listMovies := operationNamed(t, "ListMovies")
+
+	tests := []struct {
+		name    string
+		element Element
+		body    string
+		want    []Violation
+	}{
+		{
+			name:    "valid request",
+			element: updateMovie.Request,
+			body:    `<UpdateMovieRequest><id> 7 </id><title>Dune</title><releaseDate>2021-10-22</releaseDate></UpdateMovieRequest>`,
+		},
+		{
+			name:    "children in the target namespace",
+			element: listMovies.Request,
+			body:    `<ListMoviesRequest xmlns="http://example.com/moviesoap"><pageSize>2</pageSize></ListMoviesRequest>`,
+		},
+		{
+			name:    "filter and sort elements",
+			element: listMovies.Request,
+			body:    `<ListMoviesRequest><releasedFrom>2000-01-01</releasedFrom><releasedTo>2020-13-01</releasedTo><sortBy>title</sortBy><descending>yes</descending></ListMoviesRequest>`,
+			want: []Violation{
+				{Path: "ListMoviesRequest/releasedTo", Description: "must be an xsd:date"},
+				{Path: "ListMoviesRequest/descending", Description: "must be an xsd:boolean"},
+			},
+		},
+		{
+			name:    "wrong types",
+			element: updateMovie.Request,
+			body:    `<UpdateMovieRequest><id>abc</id><title><b>Dune</b></title><releaseDate>2021-10-22</releaseDate></UpdateMovieRequest>`,
+			want: []Violation{
+				{Path: "UpdateMovieRequest/id", Description: "must be an xsd:long"},
+				{Path: "UpdateMovieRequest/title/b", Description: "is not allowed here"},
+			},
+		},
+		{
+			name:    "missing, repeated, unknown and out of order elements",
+			element: updateMovie.Request,
+			body:    `<UpdateMovieRequest><title>a</title><title>b</title><id>1</id><rating>5</rating></UpdateMovieRequest>`,
+			want: []Violation{
+				{Path: "UpdateMovieRequest/id", Description: "is required"},
+				{Path: "UpdateMovieRequest/title", Description: "must not be repeated"},
+				{Path: "UpdateMovieRequest/id", Description: "is out of order"},
+				{Path: "UpdateMovieRequest/rating", Description: "is not allowed here"},
+				{Path: "UpdateMovieRequest/releaseDate", Description: "is required"},
+			},
+		},
+		{
+			name:    "base64 content",
+			element: Element{Name: "Image", Fields: []Field{{Name: "image", Type: "xsd:base64Binary"}, {Name: "thumbnail", Type: "xsd:base64Binary"}}},
+			body:    "<Image><image>iVBO\n  Rw0K</image><thumbnail>not base64!</thumbnail></Image>",
+			want: []Violation{
+				{Path: "Image/thumbnail", Description: "must be an xsd:base64Binary"},
+			},
+		},
+		{
+			name:    "date content",
+			element: Element{Name: "Dates", Fields: []Field{{Name: "utc", Type: "xsd:date"}, {Name: "offset", Type: "xsd:date"}, {Name: "invalid", Type: "xsd:date"}}},
+			body:    "<Dates><utc>2021-10-22Z</utc><offset> 2021-10-22+07:00 </offset><invalid>2021-02-30</invalid></Dates>",
+			want: []Violation{
+				{Path: "Dates/invalid", Description: "must be an xsd:date"},
+			},
+		},
+		{
+			name:    "int overflow and foreign namespace",
+			element: listMovies.Request,
+			body:    `<ListMoviesRequest xmlns:x="urn:x"><pageSize>3000000000</pageSize><x:pageToken>a</x:pageToken></ListMoviesRequest>`,
+			want: []Violation{
+				{Path: "ListMoviesRequest/pageSize", Description: "must be an xsd:int"},
+				{Path: "ListMoviesRequest/pageToken", Description: "is not in the schema namespace"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := MovieService.Validate(tt.element, xml.NewDecoder(strings.NewReader(tt.body)))
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
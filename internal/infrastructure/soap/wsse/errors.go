@@ -0,0 +1,25 @@
+package wsse
+
+// Fault codes defined by WS-Security 1.0, section 12.
+const (
+	FaultInvalidSecurity          = "wsse:InvalidSecurity"
+	FaultUnsupportedSecurityToken = "wsse:UnsupportedSecurityToken"
+	FaultFailedAuthentication     = "wsse:FailedAuthentication"
+	FaultMessageExpired           = "wsse:MessageExpired"
+)
+
+// Error is reported to the client as a SOAP fault whose faultcode is Code.
+type Error struct {
+	Code    string
+	Message string
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+// The spec asks for the same message on every authentication failure so
+// callers cannot tell unknown users from wrong passwords.
+func failedAuthentication() *Error {
+	return &Error{Code: FaultFailedAuthentication, Message: "The security token could not be authenticated or authorized"}
+}
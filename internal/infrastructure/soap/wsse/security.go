@@ -0,0 +1,40 @@
+package wsse
+
+import "encoding/xml"
+
+const (
+	Namespace = "http://docs.oasis-open.org/wss/2004/01/oasis-200401-wss-wssecurity-secext-1.0.xsd"
+
+	passwordDigestType = "http://docs.oasis-open.org/wss/2004/01/oasis-200401-wss-username-token-profile-1.0#PasswordDigest"
+	base64BinaryType   = "http://docs.oasis-open.org/wss/2004/01/oasis-200401-wss-soap-message-security-1.0#Base64Binary"
+)
+
+// Security is the <wsse:Security> SOAP header. Elements are matched by local
+// name so clients may use any prefix.
+type Security struct {
+	XMLName       xml.Name       `xml:"Security"`
+	UsernameToken *UsernameToken `xml:"UsernameToken"`
+	Timestamp     *Timestamp     `xml:"Timestamp"`
+}
+
+type UsernameToken struct {
+	Username string   `xml:"Username"`
+	Password Password `xml:"Password"`
+	Nonce    Nonce    `xml:"Nonce"`
+	Created  string   `xml:"Created"`
+}
+
+type Password struct {
+	Type  string `xml:"Type,attr"`
+	Value string `xml:",chardata"`
+}
+
+type Nonce struct {
+	EncodingType string `xml:"EncodingType,attr"`
+	Value        string `xml:",chardata"`
+}
+
+type Timestamp struct {
+	Created string `xml:"Created"`
+	Expires string `xml:"Expires"`
+}
@@ -0,0 +1,158 @@
+package wsse
+
+import (
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/base64"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sorrawichYooboon/go-protocol-api-style/internal/domain"
+)
+
+const (
+	// MaxAge is how old a UsernameToken may be, and how long its nonce is
+	// remembered to reject replays.
+	MaxAge = 5 * time.Minute
+	// clockSkew tolerates clients whose clocks run slightly ahead.
+	clockSkew = 30 * time.Second
+)
+
+type Config struct {
+	// Users maps usernames to their plain passwords. PasswordDigest needs
+	// the plain password on the server, so these cannot be hashed.
+	Users  map[string]string
+	Scopes []string
+}
+
+type Validator struct {
+	users  map[string]string
+	scopes []string
+	now    func() time.Time
+
+	mu     sync.Mutex
+	nonces map[string]time.Time
+	// expiry holds the keys of nonces in the order they were seen, so
+	// expired ones are dropped from the front without scanning the map.
+	expiry []seenNonce
+}
+
+type seenNonce struct {
+	key  string
+	seen time.Time
+}
+
+func NewValidator(cfg Config) *Validator {
+	return &Validator{
+		users:  cfg.Users,
+		scopes: cfg.Scopes,
+		now:    time.Now,
+		nonces: make(map[string]time.Time),
+	}
+}
+
+// Validate checks the timestamp and UsernameToken of a Security header and
+// returns the principal of the authenticated user. Errors are always *Error.
+func (v *Validator) Validate(sec *Security) (*domain.Principal, error) {
+	now := v.now()
+
+	if sec.Timestamp != nil {
+		if err := checkTimestamp(sec.Timestamp, now); err != nil {
+			return nil, err
+		}
+	}
+
+	token := sec.UsernameToken
+	if token == nil {
+		return nil, &Error{Code: FaultInvalidSecurity, Message: "Security header has no UsernameToken"}
+	}
+	if token.Password.Type != passwordDigestType {
+		return nil, &Error{Code: FaultUnsupportedSecurityToken, Message: "Only PasswordDigest UsernameTokens are accepted"}
+	}
+	if token.Nonce.EncodingType != "" && token.Nonce.EncodingType != base64BinaryType {
+		return nil, &Error{Code: FaultUnsupportedSecurityToken, Message: "Nonce must be Base64Binary encoded"}
+	}
+	nonce, err := base64.StdEncoding.DecodeString(strings.TrimSpace(token.Nonce.Value))
+	if err != nil || len(nonce) == 0 {
+		return nil, &Error{Code: FaultInvalidSecurity, Message: "UsernameToken has no valid Nonce"}
+	}
+	created, err := time.Parse(time.RFC3339, strings.TrimSpace(token.Created))
+	if err != nil {
+		return nil, &Error{Code: FaultInvalidSecurity, Message: "UsernameToken has no valid Created time"}
+	}
+	if created.After(now.Add(clockSkew)) || now.Sub(created) > MaxAge {
+		return nil, &Error{Code: FaultMessageExpired, Message: "UsernameToken is expired"}
+	}
+
+	password, ok := v.users[token.Username]
+	if !ok {
+		return nil, failedAuthentication()
+	}
+	if !digestMatches(strings.TrimSpace(token.Password.Value), nonce, token.Created, password) {
+		return nil, failedAuthentication()
+	}
+	if !v.rememberNonce(token.Username+"\x00"+string(nonce), now) {
+		return nil, failedAuthentication()
+	}
+
+	return &domain.Principal{
+		Subject: "wsse:" + token.Username,
+		Name:    token.Username,
+		Scopes:  v.scopes,
+	}, nil
+}
+
+func checkTimestamp(ts *Timestamp, now time.Time) error {
+	if ts.Created != "" {
+		created, err := time.Parse(time.RFC3339, strings.TrimSpace(ts.Created))
+		if err != nil {
+			return &Error{Code: FaultInvalidSecurity, Message: "Timestamp has an invalid Created time"}
+		}
+		if created.After(now.Add(clockSkew)) {
+			return &Error{Code: FaultMessageExpired, Message: "Timestamp is in the future"}
+		}
+	}
+	if ts.Expires != "" {
+		expires, err := time.Parse(time.RFC3339, strings.TrimSpace(ts.Expires))
+		if err != nil {
+			return &Error{Code: FaultInvalidSecurity, Message: "Timestamp has an invalid Expires time"}
+		}
+		if !now.Before(expires.Add(clockSkew)) {
+			return &Error{Code: FaultMessageExpired, Message: "Message has expired"}
+		}
+	}
+	return nil
+}
+
+// PasswordDigest = Base64(SHA-1(nonce + created + password)).
+func digestMatches(digest string, nonce []byte, created, password string) bool {
+	h := sha1.New()
+	h.Write(nonce)
+	h.Write([]byte(created))
+	h.Write([]byte(password))
+	want := base64.StdEncoding.EncodeToString(h.Sum(nil))
+	return subtle.ConstantTimeCompare([]byte(digest), []byte(want)) == 1
+}
+
+// rememberNonce returns false if the nonce was already used within MaxAge.
+// Nonces are seen in time order, so forgetting expired ones only looks at the
+// front of the queue and costs amortized O(1) per request.
+func (v *Validator) rememberNonce(key string, now time.Time) bool {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	expired := 0
+	for expired < len(v.expiry) && now.Sub(v.expiry[expired].seen) > MaxAge+clockSkew {
+		delete(v.nonces, v.expiry[expired].key)
+		expired++
+	}
+	v.expiry = v.expiry[expired:]
+
+	if _, ok := v.nonces[key]; ok {
+		return false
+	}
+	v.nonces[key] = now
+	v.expiry = append(v.expiry, seenNonce{key: key, seen: now})
+	return true
+}
@@ -0,0 +1,137 @@
+package wsse
+
+import (
+	"crypto/sha1"
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"github.com/sorrawichYooboon/go-protocol-api-style/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestValidator(now time.Time) *Validator {
+	v := NewValidator(Config{
+		Users:  map[string]string{"partner": "secret"},
+		Scopes: []string{"movies:read"},
+	})
+	v.now = func() time.Time { return now }
+	return v
+}
+
+func usernameToken(user, password, nonce string, created time.Time) *UsernameToken {
+	createdStr := created.UTC().Format(time.RFC3339)
+	h := sha1.New()
+	h.Write([]byte(nonce))
+	h.Write([]byte(createdStr))
+	h.Write([]byte(password))
+	return &UsernameToken{
+		Username: user,
+		Password: Password{Type: passwordDigestType, Value: base64.StdEncoding.EncodeToString(h.Sum(nil))},
+		Nonce:    Nonce{EncodingType: base64BinaryType, Value: base64.StdEncoding.EncodeToString([]byte(nonce))},
+		Created:  createdStr,
+	}
+}
+
+func TestValidator_Validate(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		security *Security
+		wantCode string
+	}{
+		{
+			name:     "valid digest",
+			security: &Security{UsernameToken: usernameToken("partner", "secret", "n1", now.Add(-time.Minute))},
+		},
+		{
+			name: "valid digest with timestamp",
+			security: &Security{
+				UsernameToken: usernameToken("partner", "secret", "n1", now),
+				Timestamp:     &Timestamp{Created: now.Format(time.RFC3339), Expires: now.Add(time.Minute).Format(time.RFC3339)},
+			},
+		},
+		{
+			name:     "wrong password",
+			security: &Security{UsernameToken: usernameToken("partner", "wrong", "n1", now)},
+			wantCode: FaultFailedAuthentication,
+		},
+		{
+			name:     "unknown user",
+			security: &Security{UsernameToken: usernameToken("someone", "secret", "n1", now)},
+			wantCode: FaultFailedAuthentication,
+		},
+		{
+			name: "password text",
+			security: &Security{UsernameToken: &UsernameToken{
+				Username: "partner",
+				Password: Password{Type: "http://docs.oasis-open.org/wss/2004/01/oasis-200401-wss-username-token-profile-1.0#PasswordText", Value: "secret"},
+			}},
+			wantCode: FaultUnsupportedSecurityToken,
+		},
+		{
+			name:     "stale token",
+			security: &Security{UsernameToken: usernameToken("partner", "secret", "n1", now.Add(-MaxAge-time.Second))},
+			wantCode: FaultMessageExpired,
+		},
+		{
+			name: "expired timestamp",
+			security: &Security{
+				UsernameToken: usernameToken("partner", "secret", "n1", now),
+				Timestamp:     &Timestamp{Created: now.Add(-2 * time.Minute).Format(time.RFC3339), Expires: now.Add(-time.Minute).Format(time.RFC3339)},
+			},
+			wantCode: FaultMessageExpired,
+		},
+		{
+			name:     "missing username token",
+			security: &Security{},
+			wantCode: FaultInvalidSecurity,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			principal, err := newTestValidator(now).Validate(test.security)
+			if test.wantCode != "" {
+				require.Error(t, err)
+				assert.Equal(t, test.wantCode, err.(*Error).Code)
+				assert.Nil(t, principal)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, &domain.Principal{Subject: "wsse:partner", Name: "partner", Scopes: []string{"movies:read"}}, principal)
+		})
+	}
+}
+
+func TestValidator_RejectsReplayedNonce(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	v := newTestValidator(now)
+	token := usernameToken("partner", "secret", "n1", now)
+
+	_, err := v.Validate(&Security{UsernameToken: token})
+	require.NoError(t, err)
+
+	_, err = v.Validate(&Security{UsernameToken: token})
+	require.Error(t, err)
+	assert.Equal(t, FaultFailedAuthentication, err.(*Error).Code)
+}
+
+func TestValidator_ForgetsExpiredNonces(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	v := newTestValidator(now)
+	for _, nonce := range []string{"n1", "n2"} {
+		_, err := v.Validate(&Security{UsernameToken: usernameToken("partner", "secret", nonce, now)})
+		require.NoError(t, err)
+	}
+
+	later := now.Add(MaxAge + clockSkew + time.Second)
+	v.now = func() time.Time { return later }
+	_, err := v.Validate(&Security{UsernameToken: usernameToken("partner", "secret", "n3", later)})
+	require.NoError(t, err)
+
+	assert.Len(t, v.nonces, 1)
+	assert.Equal(t, []seenNonce{{key: "partner\x00n3", seen: later}}, v.expiry)
+}
@@ -0,0 +1,119 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package mockRepo
+
+import (
+	context "context"
+
+	domain "github.com/sorrawichYooboon/go-protocol-api-style/internal/domain"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// APIKeyRepository is an autogenerated mock type for the APIKeyRepository type
+type APIKeyRepository struct {
+	mock.Mock
+}
+
+// Create provides a mock function with given fields: ctx, key
+func (_m *APIKeyRepository) Create(ctx context.Context, key *domain.APIKey) (*domain.APIKey, error) {
+	ret := _m.Called(ctx, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 *domain.APIKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.APIKey) (*domain.APIKey, error)); ok {
+		return rf(ctx, key)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.APIKey) *domain.APIKey); ok {
+		r0 = rf(ctx, key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.APIKey)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *domain.APIKey) error); ok {
+		r1 = rf(ctx, key)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetByHash provides a mock function with given fields: ctx, keyHash
+func (_m *APIKeyRepository) GetByHash(ctx context.Context, keyHash string) (*domain.APIKey, error) {
+	ret := _m.Called(ctx, keyHash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByHash")
+	}
+
+	var r0 *domain.APIKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*domain.APIKey, error)); ok {
+		return rf(ctx, keyHash)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *domain.APIKey); ok {
+		r0 = rf(ctx, keyHash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.APIKey)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, keyHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Revoke provides a mock function with given fields: ctx, id
+func (_m *APIKeyRepository) Revoke(ctx context.Context, id int64) (*domain.APIKey, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Revoke")
+	}
+
+	var r0 *domain.APIKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (*domain.APIKey, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) *domain.APIKey); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.APIKey)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewAPIKeyRepository creates a new instance of APIKeyRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAPIKeyRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *APIKeyRepository {
+	mock := &APIKeyRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
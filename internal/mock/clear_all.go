@@ -5,3 +5,23 @@ import "github.com/stretchr/testify/mock"
 func (m *MovieRepository) ClearAll() {
 	m.Mock = mock.Mock{}
 }
+
+func (m *MovieIterator) ClearAll() {
+	m.Mock = mock.Mock{}
+}
+
+func (m *HealthRepository) ClearAll() {
+	m.Mock = mock.Mock{}
+}
+
+func (m *APIKeyRepository) ClearAll() {
+	m.Mock = mock.Mock{}
+}
+
+func (m *PosterRepository) ClearAll() {
+	m.Mock = mock.Mock{}
+}
+
+func (m *MovieEventBus) ClearAll() {
+	m.Mock = mock.Mock{}
+}
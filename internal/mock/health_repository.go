@@ -0,0 +1,81 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package mockRepo
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// HealthRepository is an autogenerated mock type for the HealthRepository type
+type HealthRepository struct {
+	mock.Mock
+}
+
+// MigrationVersion provides a mock function with given fields: ctx
+func (_m *HealthRepository) MigrationVersion(ctx context.Context) (uint, bool, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MigrationVersion")
+	}
+
+	var r0 uint
+	var r1 bool
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context) (uint, bool, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) uint); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(uint)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) bool); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context) error); ok {
+		r2 = rf(ctx)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// Ping provides a mock function with given fields: ctx
+func (_m *HealthRepository) Ping(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Ping")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewHealthRepository creates a new instance of HealthRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewHealthRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *HealthRepository {
+	mock := &HealthRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,54 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package mockRepo
+
+import (
+	context "context"
+
+	domain "github.com/sorrawichYooboon/go-protocol-api-style/internal/domain"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MovieEventBus is an autogenerated mock type for the MovieEventBus type
+type MovieEventBus struct {
+	mock.Mock
+}
+
+// Publish provides a mock function with given fields: event
+func (_m *MovieEventBus) Publish(event domain.MovieEvent) {
+	_m.Called(event)
+}
+
+// Subscribe provides a mock function with given fields: ctx
+func (_m *MovieEventBus) Subscribe(ctx context.Context) <-chan domain.MovieEvent {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Subscribe")
+	}
+
+	var r0 <-chan domain.MovieEvent
+	if rf, ok := ret.Get(0).(func(context.Context) <-chan domain.MovieEvent); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan domain.MovieEvent)
+		}
+	}
+
+	return r0
+}
+
+// NewMovieEventBus creates a new instance of MovieEventBus. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMovieEventBus(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MovieEventBus {
+	mock := &MovieEventBus{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
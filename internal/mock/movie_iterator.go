@@ -0,0 +1,111 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package mockRepo
+
+import (
+	domain "github.com/sorrawichYooboon/go-protocol-api-style/internal/domain"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MovieIterator is an autogenerated mock type for the MovieIterator type
+type MovieIterator struct {
+	mock.Mock
+}
+
+// Close provides a mock function with given fields:
+func (_m *MovieIterator) Close() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Close")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Err provides a mock function with given fields:
+func (_m *MovieIterator) Err() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Err")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Movie provides a mock function with given fields:
+func (_m *MovieIterator) Movie() (*domain.Movie, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Movie")
+	}
+
+	var r0 *domain.Movie
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (*domain.Movie, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() *domain.Movie); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Movie)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Next provides a mock function with given fields:
+func (_m *MovieIterator) Next() bool {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Next")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// NewMovieIterator creates a new instance of MovieIterator. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMovieIterator(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MovieIterator {
+	mock := &MovieIterator{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -3,8 +3,12 @@
 package mockRepo
 
 import (
+	context "context"
+
 	domain "github.com/sorrawichYooboon/go-protocol-api-style/internal/domain"
 	mock "github.com/stretchr/testify/mock"
+
+	repository "github.com/sorrawichYooboon/go-protocol-api-style/internal/repository"
 )
 
 // MovieRepository is an autogenerated mock type for the MovieRepository type
@@ -12,9 +16,69 @@ type MovieRepository struct {
 	mock.Mock
 }
 
-// GetAll provides a mock function with given fields:
-func (_m *MovieRepository) GetAll() ([]domain.Movie, error) {
-	ret := _m.Called()
+// Create provides a mock function with given fields: ctx, movie
+func (_m *MovieRepository) Create(ctx context.Context, movie *domain.Movie) (*domain.Movie, error) {
+	ret := _m.Called(ctx, movie)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 *domain.Movie
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.Movie) (*domain.Movie, error)); ok {
+		return rf(ctx, movie)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.Movie) *domain.Movie); ok {
+		r0 = rf(ctx, movie)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Movie)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *domain.Movie) error); ok {
+		r1 = rf(ctx, movie)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Delete provides a mock function with given fields: ctx, id
+func (_m *MovieRepository) Delete(ctx context.Context, id int64) (*domain.Movie, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 *domain.Movie
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (*domain.Movie, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) *domain.Movie); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Movie)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAll provides a mock function with given fields: ctx
+func (_m *MovieRepository) GetAll(ctx context.Context) ([]domain.Movie, error) {
+	ret := _m.Called(ctx)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetAll")
@@ -22,19 +86,19 @@ func (_m *MovieRepository) GetAll() ([]domain.Movie, error) {
 
 	var r0 []domain.Movie
 	var r1 error
-	if rf, ok := ret.Get(0).(func() ([]domain.Movie, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(context.Context) ([]domain.Movie, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func() []domain.Movie); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []domain.Movie); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]domain.Movie)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -42,9 +106,9 @@ func (_m *MovieRepository) GetAll() ([]domain.Movie, error) {
 	return r0, r1
 }
 
-// GetByID provides a mock function with given fields: id
-func (_m *MovieRepository) GetByID(id int64) (*domain.Movie, error) {
-	ret := _m.Called(id)
+// GetByID provides a mock function with given fields: ctx, id
+func (_m *MovieRepository) GetByID(ctx context.Context, id int64) (*domain.Movie, error) {
+	ret := _m.Called(ctx, id)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetByID")
@@ -52,19 +116,169 @@ func (_m *MovieRepository) GetByID(id int64) (*domain.Movie, error) {
 
 	var r0 *domain.Movie
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int64) (*domain.Movie, error)); ok {
-		return rf(id)
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (*domain.Movie, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) *domain.Movie); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Movie)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetByIDs provides a mock function with given fields: ctx, ids
+func (_m *MovieRepository) GetByIDs(ctx context.Context, ids []int64) ([]domain.Movie, error) {
+	ret := _m.Called(ctx, ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByIDs")
+	}
+
+	var r0 []domain.Movie
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []int64) ([]domain.Movie, error)); ok {
+		return rf(ctx, ids)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []int64) []domain.Movie); ok {
+		r0 = rf(ctx, ids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Movie)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []int64) error); ok {
+		r1 = rf(ctx, ids)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Iterate provides a mock function with given fields: ctx
+func (_m *MovieRepository) Iterate(ctx context.Context) (repository.MovieIterator, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Iterate")
+	}
+
+	var r0 repository.MovieIterator
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (repository.MovieIterator, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) repository.MovieIterator); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(repository.MovieIterator)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// List provides a mock function with given fields: ctx, filter, sort, limit, after
+func (_m *MovieRepository) List(ctx context.Context, filter domain.MovieFilter, sort domain.MovieSort, limit int, after *domain.Movie) ([]domain.Movie, error) {
+	ret := _m.Called(ctx, filter, sort, limit, after)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []domain.Movie
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.MovieFilter, domain.MovieSort, int, *domain.Movie) ([]domain.Movie, error)); ok {
+		return rf(ctx, filter, sort, limit, after)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.MovieFilter, domain.MovieSort, int, *domain.Movie) []domain.Movie); ok {
+		r0 = rf(ctx, filter, sort, limit, after)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Movie)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.MovieFilter, domain.MovieSort, int, *domain.Movie) error); ok {
+		r1 = rf(ctx, filter, sort, limit, after)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Search provides a mock function with given fields: ctx, query, limit, afterID
+func (_m *MovieRepository) Search(ctx context.Context, query string, limit int, afterID int64) ([]domain.Movie, error) {
+	ret := _m.Called(ctx, query, limit, afterID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Search")
+	}
+
+	var r0 []domain.Movie
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, int64) ([]domain.Movie, error)); ok {
+		return rf(ctx, query, limit, afterID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, int64) []domain.Movie); ok {
+		r0 = rf(ctx, query, limit, afterID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Movie)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int, int64) error); ok {
+		r1 = rf(ctx, query, limit, afterID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Update provides a mock function with given fields: ctx, movie
+func (_m *MovieRepository) Update(ctx context.Context, movie *domain.Movie) (*domain.Movie, error) {
+	ret := _m.Called(ctx, movie)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 *domain.Movie
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.Movie) (*domain.Movie, error)); ok {
+		return rf(ctx, movie)
 	}
-	if rf, ok := ret.Get(0).(func(int64) *domain.Movie); ok {
-		r0 = rf(id)
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.Movie) *domain.Movie); ok {
+		r0 = rf(ctx, movie)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*domain.Movie)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(int64) error); ok {
-		r1 = rf(id)
+	if rf, ok := ret.Get(1).(func(context.Context, *domain.Movie) error); ok {
+		r1 = rf(ctx, movie)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -0,0 +1,95 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package mockRepo
+
+import (
+	context "context"
+
+	domain "github.com/sorrawichYooboon/go-protocol-api-style/internal/domain"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// PosterRepository is an autogenerated mock type for the PosterRepository type
+type PosterRepository struct {
+	mock.Mock
+}
+
+// Delete provides a mock function with given fields: ctx, movieID
+func (_m *PosterRepository) Delete(ctx context.Context, movieID int64) error {
+	ret := _m.Called(ctx, movieID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, movieID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Get provides a mock function with given fields: ctx, movieID
+func (_m *PosterRepository) Get(ctx context.Context, movieID int64) (*domain.Poster, error) {
+	ret := _m.Called(ctx, movieID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *domain.Poster
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (*domain.Poster, error)); ok {
+		return rf(ctx, movieID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) *domain.Poster); ok {
+		r0 = rf(ctx, movieID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Poster)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, movieID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Put provides a mock function with given fields: ctx, poster
+func (_m *PosterRepository) Put(ctx context.Context, poster *domain.Poster) error {
+	ret := _m.Called(ctx, poster)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Put")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.Poster) error); ok {
+		r0 = rf(ctx, poster)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewPosterRepository creates a new instance of PosterRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPosterRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PosterRepository {
+	mock := &PosterRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/sorrawichYooboon/go-protocol-api-style/internal/domain"
+)
+
+type APIKeyRepository interface {
+	Create(ctx context.Context, key *domain.APIKey) (*domain.APIKey, error)
+	GetByHash(ctx context.Context, keyHash string) (*domain.APIKey, error)
+	Revoke(ctx context.Context, id int64) (*domain.APIKey, error)
+}
@@ -0,0 +1,8 @@
+package repository
+
+import "errors"
+
+var (
+	ErrConflict    = errors.New("repository: conflict")
+	ErrUnavailable = errors.New("repository: unavailable")
+)
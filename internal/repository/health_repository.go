@@ -0,0 +1,8 @@
+package repository
+
+import "context"
+
+type HealthRepository interface {
+	Ping(ctx context.Context) error
+	MigrationVersion(ctx context.Context) (version uint, dirty bool, err error)
+}
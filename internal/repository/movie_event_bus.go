@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/sorrawichYooboon/go-protocol-api-style/internal/domain"
+)
+
+// MovieEventBus fans movie changes out to subscribers. Publish must not
+// block on slow subscribers.
+type MovieEventBus interface {
+	Publish(event domain.MovieEvent)
+	// Subscribe returns a channel of events published after the call. It is
+	// closed once ctx is done.
+	Subscribe(ctx context.Context) <-chan domain.MovieEvent
+}
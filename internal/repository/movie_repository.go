@@ -1,8 +1,32 @@
 package repository
 
-import "github.com/sorrawichYooboon/go-protocol-api-style/internal/domain"
+import (
+	"context"
+
+	"github.com/sorrawichYooboon/go-protocol-api-style/internal/domain"
+)
 
 type MovieRepository interface {
-	GetAll() ([]domain.Movie, error)
-	GetByID(id int64) (*domain.Movie, error)
+	GetAll(ctx context.Context) ([]domain.Movie, error)
+	// List returns up to limit movies matching filter in sort order, starting
+	// after the movie after, or from the start when after is nil. Only the id
+	// and sort key of after are used.
+	List(ctx context.Context, filter domain.MovieFilter, sort domain.MovieSort, limit int, after *domain.Movie) ([]domain.Movie, error)
+	Search(ctx context.Context, query string, limit int, afterID int64) ([]domain.Movie, error)
+	Iterate(ctx context.Context) (MovieIterator, error)
+	GetByID(ctx context.Context, id int64) (*domain.Movie, error)
+	// GetByIDs returns the movies that exist among ids, in no particular order.
+	GetByIDs(ctx context.Context, ids []int64) ([]domain.Movie, error)
+	Create(ctx context.Context, movie *domain.Movie) (*domain.Movie, error)
+	Update(ctx context.Context, movie *domain.Movie) (*domain.Movie, error)
+	Delete(ctx context.Context, id int64) (*domain.Movie, error)
+}
+
+// MovieIterator walks movies row by row without loading the whole table.
+// Callers must always Close it.
+type MovieIterator interface {
+	Next() bool
+	Movie() (*domain.Movie, error)
+	Err() error
+	Close() error
 }
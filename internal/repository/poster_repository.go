@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/sorrawichYooboon/go-protocol-api-style/internal/domain"
+)
+
+// PosterRepository stores one poster image per movie. Get returns nil, nil
+// and Delete returns nil when the movie has no poster.
+type PosterRepository interface {
+	Put(ctx context.Context, poster *domain.Poster) error
+	Get(ctx context.Context, movieID int64) (*domain.Poster, error)
+	Delete(ctx context.Context, movieID int64) error
+}
@@ -0,0 +1,138 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sorrawichYooboon/go-protocol-api-style/internal/domain"
+	mockRepo "github.com/sorrawichYooboon/go-protocol-api-style/internal/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_authUsecase_authenticateAPIKey(t *testing.T) {
+	mockAPIKeyRepo := mockRepo.NewAPIKeyRepository(t)
+
+	clearAllMock := func() {
+		mockAPIKeyRepo.ClearAll()
+	}
+
+	// sha256("secret")
+	secretHash := "2bb80d537b1da3e38bd30361aa855686bde0eacd7162fef6a25fe97bf527a25b"
+	revokedAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		mockServiceReq string
+
+		wantServiceOrRepoCallWithAndResponse func()
+		wantServiceOrRepoCallTimes           map[string]map[string]int
+		wantMainServiceError                 error
+		wantMainServiceResponse              interface{}
+	}{
+		{
+			name:           "Test should return unauthenticated error when key is empty",
+			mockServiceReq: "",
+			wantServiceOrRepoCallTimes: map[string]map[string]int{
+				"apiKeyRepository": {
+					"GetByHash": 0,
+				},
+			},
+			wantMainServiceError:    NewUnauthenticatedError("missing API key"),
+			wantMainServiceResponse: nil,
+		},
+		{
+			name:           "Test should return error when api key repository GetByHash returns error",
+			mockServiceReq: "secret",
+			wantServiceOrRepoCallWithAndResponse: func() {
+				mockAPIKeyRepo.On("GetByHash", mock.Anything, secretHash).Return(nil, assert.AnError)
+			},
+			wantServiceOrRepoCallTimes: map[string]map[string]int{
+				"apiKeyRepository": {
+					"GetByHash": 1,
+				},
+			},
+			wantMainServiceError:    assert.AnError,
+			wantMainServiceResponse: nil,
+		},
+		{
+			name:           "Test should return unauthenticated error when key is unknown",
+			mockServiceReq: "secret",
+			wantServiceOrRepoCallWithAndResponse: func() {
+				mockAPIKeyRepo.On("GetByHash", mock.Anything, secretHash).Return(nil, nil)
+			},
+			wantServiceOrRepoCallTimes: map[string]map[string]int{
+				"apiKeyRepository": {
+					"GetByHash": 1,
+				},
+			},
+			wantMainServiceError:    NewUnauthenticatedError("invalid API key"),
+			wantMainServiceResponse: nil,
+		},
+		{
+			name:           "Test should return unauthenticated error when key is revoked",
+			mockServiceReq: "secret",
+			wantServiceOrRepoCallWithAndResponse: func() {
+				mockAPIKeyRepo.On("GetByHash", mock.Anything, secretHash).Return(&domain.APIKey{ID: 1, Name: "ci", RevokedAt: &revokedAt}, nil)
+			},
+			wantServiceOrRepoCallTimes: map[string]map[string]int{
+				"apiKeyRepository": {
+					"GetByHash": 1,
+				},
+			},
+			wantMainServiceError:    NewUnauthenticatedError("invalid API key"),
+			wantMainServiceResponse: nil,
+		},
+		{
+			name:           "Test should return principal when key is active",
+			mockServiceReq: "secret",
+			wantServiceOrRepoCallWithAndResponse: func() {
+				mockAPIKeyRepo.On("GetByHash", mock.Anything, secretHash).Return(&domain.APIKey{ID: 1, Name: "ci", Scopes: "movies:read movies:write"}, nil)
+			},
+			wantServiceOrRepoCallTimes: map[string]map[string]int{
+				"apiKeyRepository": {
+					"GetByHash": 1,
+				},
+			},
+			wantMainServiceError:    nil,
+			wantMainServiceResponse: &domain.Principal{Subject: "apikey:1", Name: "ci", Scopes: []string{ScopeMoviesRead, ScopeMoviesWrite}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			defer clearAllMock()
+
+			if test.wantServiceOrRepoCallWithAndResponse != nil {
+				test.wantServiceOrRepoCallWithAndResponse()
+			}
+
+			authUsecase := NewAuthUsecase(mockAPIKeyRepo)
+			response, err := authUsecase.AuthenticateAPIKey(context.Background(), test.mockServiceReq)
+
+			if test.wantMainServiceError != nil {
+				assert.Equal(t, test.wantMainServiceError.Error(), err.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+
+			if test.wantMainServiceResponse != nil {
+				assert.Equal(t, test.wantMainServiceResponse, response)
+			} else {
+				assert.Nil(t, response)
+			}
+
+			for serviceName, serviceCallTimes := range test.wantServiceOrRepoCallTimes {
+				for methodName, times := range serviceCallTimes {
+					switch serviceName {
+					case "apiKeyRepository":
+						mockAPIKeyRepo.AssertNumberOfCalls(t, methodName, times)
+					default:
+						t.Errorf("service %s or method %s not found", serviceName, methodName)
+					}
+				}
+			}
+		})
+	}
+}
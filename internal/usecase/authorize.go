@@ -0,0 +1,23 @@
+package usecase
+
+import "context"
+
+const (
+	ScopeMoviesRead  = "movies:read"
+	ScopeMoviesWrite = "movies:write"
+)
+
+var knownScopes = []string{ScopeMoviesRead, ScopeMoviesWrite}
+
+// authorize is called at the top of every usecase method so the same rules
+// apply whichever transport authenticated the caller.
+func authorize(ctx context.Context, scope string) error {
+	principal, ok := PrincipalFromContext(ctx)
+	if !ok {
+		return NewUnauthenticatedError("authentication required")
+	}
+	if !principal.HasScope(scope) {
+		return NewPermissionDeniedError("missing scope " + scope)
+	}
+	return nil
+}
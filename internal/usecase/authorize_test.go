@@ -0,0 +1,124 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sorrawichYooboon/go-protocol-api-style/internal/domain"
+	mockRepo "github.com/sorrawichYooboon/go-protocol-api-style/internal/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func authorizedContext(scopes ...string) context.Context {
+	if len(scopes) == 0 {
+		scopes = []string{ScopeMoviesRead, ScopeMoviesWrite}
+	}
+	return WithPrincipal(context.Background(), &domain.Principal{Subject: "apikey:1", Name: "test", Scopes: scopes})
+}
+
+func Test_movieUsecase_authorize(t *testing.T) {
+	mockMovieRepo := mockRepo.NewMovieRepository(t)
+	mockPosterRepo := mockRepo.NewPosterRepository(t)
+	mockMovieEventBus := mockRepo.NewMovieEventBus(t)
+
+	clearAllMock := func() {
+		mockMovieRepo.ClearAll()
+	}
+
+	tests := []struct {
+		name           string
+		mockServiceReq context.Context
+		call           func(ctx context.Context, movieUsecase MovieUsecase) (*domain.Movie, error)
+
+		wantServiceOrRepoCallWithAndResponse func()
+		wantServiceOrRepoCallTimes           map[string]map[string]int
+		wantMainServiceError                 error
+	}{
+		{
+			name:           "Test should return unauthenticated error when context has no principal",
+			mockServiceReq: context.Background(),
+			call: func(ctx context.Context, movieUsecase MovieUsecase) (*domain.Movie, error) {
+				return movieUsecase.GetMovieByID(ctx, 1)
+			},
+			wantServiceOrRepoCallTimes: map[string]map[string]int{
+				"movieRepository": {
+					"GetByID": 0,
+				},
+			},
+			wantMainServiceError: NewUnauthenticatedError("authentication required"),
+		},
+		{
+			name:           "Test should return permission denied error when principal cannot write",
+			mockServiceReq: authorizedContext(ScopeMoviesRead),
+			call: func(ctx context.Context, movieUsecase MovieUsecase) (*domain.Movie, error) {
+				return movieUsecase.DeleteMovie(ctx, 1)
+			},
+			wantServiceOrRepoCallTimes: map[string]map[string]int{
+				"movieRepository": {
+					"Delete": 0,
+				},
+			},
+			wantMainServiceError: NewPermissionDeniedError("missing scope movies:write"),
+		},
+		{
+			name:           "Test should return permission denied error when principal cannot read",
+			mockServiceReq: authorizedContext(ScopeMoviesWrite),
+			call: func(ctx context.Context, movieUsecase MovieUsecase) (*domain.Movie, error) {
+				return movieUsecase.GetMovieByID(ctx, 1)
+			},
+			wantServiceOrRepoCallTimes: map[string]map[string]int{
+				"movieRepository": {
+					"GetByID": 0,
+				},
+			},
+			wantMainServiceError: NewPermissionDeniedError("missing scope movies:read"),
+		},
+		{
+			name:           "Test should call repository when principal can read",
+			mockServiceReq: authorizedContext(ScopeMoviesRead),
+			call: func(ctx context.Context, movieUsecase MovieUsecase) (*domain.Movie, error) {
+				return movieUsecase.GetMovieByID(ctx, 1)
+			},
+			wantServiceOrRepoCallWithAndResponse: func() {
+				mockMovieRepo.On("GetByID", mock.Anything, int64(1)).Return(&domain.Movie{ID: 1}, nil)
+			},
+			wantServiceOrRepoCallTimes: map[string]map[string]int{
+				"movieRepository": {
+					"GetByID": 1,
+				},
+			},
+			wantMainServiceError: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			defer clearAllMock()
+
+			if test.wantServiceOrRepoCallWithAndResponse != nil {
+				test.wantServiceOrRepoCallWithAndResponse()
+			}
+
+			movieUsecase := NewMovieUsecase(mockMovieRepo, mockPosterRepo, mockMovieEventBus)
+			_, err := test.call(test.mockServiceReq, movieUsecase)
+
+			if test.wantMainServiceError != nil {
+				assert.Equal(t, test.wantMainServiceError, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			for serviceName, serviceCallTimes := range test.wantServiceOrRepoCallTimes {
+				for methodName, times := range serviceCallTimes {
+					switch serviceName {
+					case "movieRepository":
+						mockMovieRepo.AssertNumberOfCalls(t, methodName, times)
+					default:
+						t.Errorf("service %s or method %s not found", serviceName, methodName)
+					}
+				}
+			}
+		})
+	}
+}
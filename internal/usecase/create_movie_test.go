@@ -0,0 +1,148 @@
+package usecase
+
+import (
+	"testing"
+	"time"
+
+	"github.com/sorrawichYooboon/go-protocol-api-style/internal/domain"
+	mockRepo "github.com/sorrawichYooboon/go-protocol-api-style/internal/mock"
+	"github.com/sorrawichYooboon/go-protocol-api-style/internal/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_movieUsecase_createMovie(t *testing.T) {
+	mockMovieRepo := mockRepo.NewMovieRepository(t)
+	mockPosterRepo := mockRepo.NewPosterRepository(t)
+	mockMovieEventBus := mockRepo.NewMovieEventBus(t)
+
+	clearAllMock := func() {
+		mockMovieRepo.ClearAll()
+		mockMovieEventBus.ClearAll()
+	}
+
+	validMovie := &domain.Movie{Title: "Dune", ReleaseDate: domain.Date{Year: 2021, Month: time.October, Day: 22}}
+
+	tests := []struct {
+		name           string
+		mockServiceReq *domain.Movie
+
+		wantServiceOrRepoCallWithAndResponse func()
+		wantServiceOrRepoCallTimes           map[string]map[string]int
+		wantMainServiceError                 error
+		wantMainServiceErrorCode             ErrorCode
+		wantMainServiceResponse              interface{}
+	}{
+		{
+			name:           "Test should return invalid argument error when movie is invalid",
+			mockServiceReq: &domain.Movie{Title: " ", ReleaseDate: domain.Date{Year: 2021, Month: 13, Day: 1}},
+			wantServiceOrRepoCallTimes: map[string]map[string]int{
+				"movieRepository": {
+					"Create": 0,
+				},
+				"movieEventBus": {
+					"Publish": 0,
+				},
+			},
+			wantMainServiceError: NewInvalidArgumentError("invalid movie",
+				FieldViolation{Field: "title", Description: "must not be empty"},
+				FieldViolation{Field: "releaseDate", Description: "must be a valid date between 0001-01-01 and 9999-12-31"},
+			),
+			wantMainServiceErrorCode: ErrCodeInvalidArgument,
+			wantMainServiceResponse:  nil,
+		},
+		{
+			name:           "Test should return invalid argument error when release date is missing",
+			mockServiceReq: &domain.Movie{Title: "Dune"},
+			wantServiceOrRepoCallTimes: map[string]map[string]int{
+				"movieRepository": {
+					"Create": 0,
+				},
+				"movieEventBus": {
+					"Publish": 0,
+				},
+			},
+			wantMainServiceError: NewInvalidArgumentError("invalid movie",
+				FieldViolation{Field: "releaseDate", Description: "must not be empty"},
+			),
+			wantMainServiceErrorCode: ErrCodeInvalidArgument,
+			wantMainServiceResponse:  nil,
+		},
+		{
+			name:           "Test should return conflict error when movie repository Create returns conflict",
+			mockServiceReq: validMovie,
+			wantServiceOrRepoCallWithAndResponse: func() {
+				mockMovieRepo.On("Create", mock.Anything, validMovie).Return(nil, repository.ErrConflict)
+			},
+			wantServiceOrRepoCallTimes: map[string]map[string]int{
+				"movieRepository": {
+					"Create": 1,
+				},
+				"movieEventBus": {
+					"Publish": 0,
+				},
+			},
+			wantMainServiceError:     &Error{Code: ErrCodeConflict, Message: "movie conflicts with an existing record", Err: repository.ErrConflict},
+			wantMainServiceErrorCode: ErrCodeConflict,
+			wantMainServiceResponse:  nil,
+		},
+		{
+			name:           "Test should return movie and publish created event when movie repository Create returns movie",
+			mockServiceReq: validMovie,
+			wantServiceOrRepoCallWithAndResponse: func() {
+				mockMovieRepo.On("Create", mock.Anything, validMovie).Return(&domain.Movie{ID: 4, Title: "Dune", ReleaseDate: domain.Date{Year: 2021, Month: time.October, Day: 22}}, nil)
+				mockMovieEventBus.On("Publish", domain.MovieEvent{Type: domain.MovieCreated, Movie: domain.Movie{ID: 4, Title: "Dune", ReleaseDate: domain.Date{Year: 2021, Month: time.October, Day: 22}}}).Return()
+			},
+			wantServiceOrRepoCallTimes: map[string]map[string]int{
+				"movieRepository": {
+					"Create": 1,
+				},
+				"movieEventBus": {
+					"Publish": 1,
+				},
+			},
+			wantMainServiceError:    nil,
+			wantMainServiceResponse: &domain.Movie{ID: 4, Title: "Dune", ReleaseDate: domain.Date{Year: 2021, Month: time.October, Day: 22}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			defer clearAllMock()
+
+			if test.wantServiceOrRepoCallWithAndResponse != nil {
+				test.wantServiceOrRepoCallWithAndResponse()
+			}
+
+			movieUsecase := NewMovieUsecase(mockMovieRepo, mockPosterRepo, mockMovieEventBus)
+			response, err := movieUsecase.CreateMovie(authorizedContext(), test.mockServiceReq)
+
+			if test.wantMainServiceError != nil {
+				assert.Equal(t, test.wantMainServiceError.Error(), err.Error())
+				assert.Equal(t, test.wantMainServiceErrorCode, AsError(err).Code)
+				assert.Equal(t, AsError(test.wantMainServiceError).Violations, AsError(err).Violations)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			if test.wantMainServiceResponse != nil {
+				assert.Equal(t, test.wantMainServiceResponse, response)
+			} else {
+				assert.Nil(t, response)
+			}
+
+			for serviceName, serviceCallTimes := range test.wantServiceOrRepoCallTimes {
+				for methodName, times := range serviceCallTimes {
+					switch serviceName {
+					case "movieRepository":
+						mockMovieRepo.AssertNumberOfCalls(t, methodName, times)
+					case "movieEventBus":
+						mockMovieEventBus.AssertNumberOfCalls(t, methodName, times)
+					default:
+						t.Errorf("service %s or method %s not found", serviceName, methodName)
+					}
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,99 @@
+package usecase
+
+import (
+	"encoding/base64"
+	"strconv"
+	"strings"
+
+	"github.com/sorrawichYooboon/go-protocol-api-style/internal/domain"
+)
+
+const (
+	DefaultPageSize = 20
+	MaxPageSize     = 100
+
+	movieCursorPrefix = "movie:"
+)
+
+var ErrInvalidCursor = NewInvalidArgumentError("invalid cursor", FieldViolation{Field: "cursor", Description: "must be a cursor returned by a previous page with the same sort"})
+
+// Cursors are opaque to clients; they carry the last seen movie's id and,
+// unless the listing is in id order, the sort and the movie's sort key, so the
+// repository can resume with a keyset query. A cursor only decodes under the
+// sort it was created with.
+func encodeMovieCursor(m domain.Movie, sort domain.MovieSort) string {
+	raw := movieCursorPrefix + strconv.FormatInt(m.ID, 10)
+	if sort != (domain.MovieSort{}) {
+		raw = movieCursorPrefix + sortKey(sort) + ":" + strconv.FormatInt(m.ID, 10) + ":" + movieSortValue(m, sort.Field)
+	}
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// decodeMovieCursor returns the movie the cursor points after, holding only
+// its id and sort key, or nil for the first page.
+func decodeMovieCursor(cursor string, sort domain.MovieSort) (*domain.Movie, error) {
+	if cursor == "" {
+		return nil, nil
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	rest, ok := strings.CutPrefix(string(raw), movieCursorPrefix)
+	if !ok {
+		return nil, ErrInvalidCursor
+	}
+
+	idStr, value := rest, ""
+	if sort != (domain.MovieSort{}) {
+		// The key comes last because a title may contain colons.
+		parts := strings.SplitN(rest, ":", 4)
+		if len(parts) != 4 || parts[0]+":"+parts[1] != sortKey(sort) {
+			return nil, ErrInvalidCursor
+		}
+		idStr, value = parts[2], parts[3]
+	}
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil || id < 0 {
+		return nil, ErrInvalidCursor
+	}
+
+	after := &domain.Movie{ID: id}
+	switch sort.Field {
+	case domain.MovieSortByTitle:
+		after.Title = value
+	case domain.MovieSortByReleaseDate:
+		// An empty value is a movie without a release date.
+		if err := after.ReleaseDate.UnmarshalText([]byte(value)); err != nil {
+			return nil, ErrInvalidCursor
+		}
+	}
+	return after, nil
+}
+
+func sortKey(sort domain.MovieSort) string {
+	if sort.Descending {
+		return string(sort.Field) + ":desc"
+	}
+	return string(sort.Field) + ":asc"
+}
+
+func movieSortValue(m domain.Movie, field domain.MovieSortField) string {
+	switch field {
+	case domain.MovieSortByTitle:
+		return m.Title
+	case domain.MovieSortByReleaseDate:
+		return m.ReleaseDate.String()
+	}
+	return ""
+}
+
+func normalizePageSize(pageSize int) int {
+	if pageSize <= 0 {
+		return DefaultPageSize
+	}
+	if pageSize > MaxPageSize {
+		return MaxPageSize
+	}
+	return pageSize
+}
@@ -0,0 +1,153 @@
+package usecase
+
+import (
+	"testing"
+	"time"
+
+	"github.com/sorrawichYooboon/go-protocol-api-style/internal/domain"
+	mockRepo "github.com/sorrawichYooboon/go-protocol-api-style/internal/mock"
+	"github.com/sorrawichYooboon/go-protocol-api-style/internal/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_movieUsecase_deleteMovie(t *testing.T) {
+	mockMovieRepo := mockRepo.NewMovieRepository(t)
+	mockPosterRepo := mockRepo.NewPosterRepository(t)
+	mockMovieEventBus := mockRepo.NewMovieEventBus(t)
+
+	clearAllMock := func() {
+		mockMovieRepo.ClearAll()
+		mockPosterRepo.ClearAll()
+		mockMovieEventBus.ClearAll()
+	}
+
+	dune := &domain.Movie{ID: 4, Title: "Dune", ReleaseDate: domain.Date{Year: 2021, Month: time.October, Day: 22}}
+
+	tests := []struct {
+		name           string
+		mockServiceReq int64
+
+		wantServiceOrRepoCallWithAndResponse func()
+		wantServiceOrRepoCallTimes           map[string]map[string]int
+		wantMainServiceError                 error
+		wantMainServiceResponse              interface{}
+	}{
+		{
+			name:           "Test should return not found error when movie repository Delete returns nil",
+			mockServiceReq: 7,
+			wantServiceOrRepoCallWithAndResponse: func() {
+				mockMovieRepo.On("Delete", mock.Anything, int64(7)).Return(nil, nil)
+			},
+			wantServiceOrRepoCallTimes: map[string]map[string]int{
+				"movieRepository":  {"Delete": 1},
+				"posterRepository": {"Delete": 0},
+				"movieEventBus":    {"Publish": 0},
+			},
+			wantMainServiceError:    NewNotFoundError("movie 7 not found"),
+			wantMainServiceResponse: nil,
+		},
+		{
+			name:           "Test should return unavailable error when movie repository Delete returns unavailable",
+			mockServiceReq: 4,
+			wantServiceOrRepoCallWithAndResponse: func() {
+				mockMovieRepo.On("Delete", mock.Anything, int64(4)).Return(nil, repository.ErrUnavailable)
+			},
+			wantServiceOrRepoCallTimes: map[string]map[string]int{
+				"movieRepository":  {"Delete": 1},
+				"posterRepository": {"Delete": 0},
+				"movieEventBus":    {"Publish": 0},
+			},
+			wantMainServiceError:    &Error{Code: ErrCodeUnavailable, Message: "storage is temporarily unavailable", Err: repository.ErrUnavailable},
+			wantMainServiceResponse: nil,
+		},
+		{
+			name:           "Test should delete poster and publish deleted event when movie repository Delete returns movie",
+			mockServiceReq: 4,
+			wantServiceOrRepoCallWithAndResponse: func() {
+				mockMovieRepo.On("Delete", mock.Anything, int64(4)).Return(dune, nil)
+				mockPosterRepo.On("Delete", mock.Anything, int64(4)).Return(nil)
+				mockMovieEventBus.On("Publish", domain.MovieEvent{Type: domain.MovieDeleted, Movie: *dune}).Return()
+			},
+			wantServiceOrRepoCallTimes: map[string]map[string]int{
+				"movieRepository":  {"Delete": 1},
+				"posterRepository": {"Delete": 1},
+				"movieEventBus":    {"Publish": 1},
+			},
+			wantMainServiceError:    nil,
+			wantMainServiceResponse: dune,
+		},
+		{
+			name:           "Test should still delete movie when poster repository Delete returns error",
+			mockServiceReq: 4,
+			wantServiceOrRepoCallWithAndResponse: func() {
+				mockMovieRepo.On("Delete", mock.Anything, int64(4)).Return(dune, nil)
+				mockPosterRepo.On("Delete", mock.Anything, int64(4)).Return(assert.AnError)
+				mockMovieEventBus.On("Publish", domain.MovieEvent{Type: domain.MovieDeleted, Movie: *dune}).Return()
+			},
+			wantServiceOrRepoCallTimes: map[string]map[string]int{
+				"movieRepository":  {"Delete": 1},
+				"posterRepository": {"Delete": 1},
+				"movieEventBus":    {"Publish": 1},
+			},
+			wantMainServiceError:    nil,
+			wantMainServiceResponse: dune,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			defer clearAllMock()
+
+			if test.wantServiceOrRepoCallWithAndResponse != nil {
+				test.wantServiceOrRepoCallWithAndResponse()
+			}
+
+			movieUsecase := NewMovieUsecase(mockMovieRepo, mockPosterRepo, mockMovieEventBus)
+			response, err := movieUsecase.DeleteMovie(authorizedContext(), test.mockServiceReq)
+
+			if test.wantMainServiceError != nil {
+				assert.Equal(t, test.wantMainServiceError.Error(), err.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+
+			if test.wantMainServiceResponse != nil {
+				assert.Equal(t, test.wantMainServiceResponse, response)
+			} else {
+				assert.Nil(t, response)
+			}
+
+			for serviceName, serviceCallTimes := range test.wantServiceOrRepoCallTimes {
+				for methodName, times := range serviceCallTimes {
+					switch serviceName {
+					case "movieRepository":
+						mockMovieRepo.AssertNumberOfCalls(t, methodName, times)
+					case "posterRepository":
+						mockPosterRepo.AssertNumberOfCalls(t, methodName, times)
+					case "movieEventBus":
+						mockMovieEventBus.AssertNumberOfCalls(t, methodName, times)
+					default:
+						t.Errorf("service %s or method %s not found", serviceName, methodName)
+					}
+				}
+			}
+		})
+	}
+}
+
+func Test_movieUsecase_deleteMovie_withoutPosterRepository(t *testing.T) {
+	mockMovieRepo := mockRepo.NewMovieRepository(t)
+	mockMovieEventBus := mockRepo.NewMovieEventBus(t)
+
+	dune := &domain.Movie{ID: 4, Title: "Dune"}
+	mockMovieRepo.On("Delete", mock.Anything, int64(4)).Return(dune, nil)
+	mockMovieEventBus.On("Publish", domain.MovieEvent{Type: domain.MovieDeleted, Movie: *dune}).Return()
+
+	movieUsecase := NewMovieUsecase(mockMovieRepo, nil, mockMovieEventBus)
+	response, err := movieUsecase.DeleteMovie(authorizedContext(), 4)
+
+	assert.NoError(t, err)
+	assert.Equal(t, dune, response)
+	mockMovieEventBus.AssertNumberOfCalls(t, "Publish", 1)
+}
@@ -0,0 +1,109 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/sorrawichYooboon/go-protocol-api-style/internal/repository"
+)
+
+type ErrorCode string
+
+const (
+	ErrCodeNotFound          ErrorCode = "NOT_FOUND"
+	ErrCodeInvalidArgument   ErrorCode = "INVALID_ARGUMENT"
+	ErrCodeConflict          ErrorCode = "CONFLICT"
+	ErrCodeUnauthenticated   ErrorCode = "UNAUTHENTICATED"
+	ErrCodePermissionDenied  ErrorCode = "PERMISSION_DENIED"
+	ErrCodeUnavailable       ErrorCode = "UNAVAILABLE"
+	ErrCodeResourceExhausted ErrorCode = "RESOURCE_EXHAUSTED"
+	ErrCodeInternal          ErrorCode = "INTERNAL"
+)
+
+type FieldViolation struct {
+	Field       string
+	Description string
+}
+
+// Error is the typed error returned by every usecase. Transports translate
+// Code into their native status (gRPC code, HTTP status, SOAP fault, ...).
+type Error struct {
+	Code       ErrorCode
+	Message    string
+	Violations []FieldViolation
+	// RetryAfter is set on RESOURCE_EXHAUSTED errors.
+	RetryAfter time.Duration
+	Err        error
+}
+
+func (e *Error) Error() string {
+	if e.Err != nil {
+		return e.Message + ": " + e.Err.Error()
+	}
+	return e.Message
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+func NewNotFoundError(format string, args ...any) *Error {
+	return &Error{Code: ErrCodeNotFound, Message: fmt.Sprintf(format, args...)}
+}
+
+func NewInvalidArgumentError(message string, violations ...FieldViolation) *Error {
+	return &Error{Code: ErrCodeInvalidArgument, Message: message, Violations: violations}
+}
+
+func NewUnauthenticatedError(message string) *Error {
+	return &Error{Code: ErrCodeUnauthenticated, Message: message}
+}
+
+func NewPermissionDeniedError(message string) *Error {
+	return &Error{Code: ErrCodePermissionDenied, Message: message}
+}
+
+func NewResourceExhaustedError(retryAfter time.Duration) *Error {
+	return &Error{Code: ErrCodeResourceExhausted, Message: "rate limit exceeded", RetryAfter: retryAfter}
+}
+
+// RenameViolations returns err with each violation field found in names
+// replaced, so a transport can report the names its clients actually sent.
+// The usecase names are the GraphQL and SOAP spellings, e.g. "releasedFrom".
+// Other errors are returned unchanged.
+func RenameViolations(err error, names map[string]string) error {
+	var ucErr *Error
+	if !errors.As(err, &ucErr) || len(ucErr.Violations) == 0 {
+		return err
+	}
+	renamed := *ucErr
+	renamed.Violations = make([]FieldViolation, len(ucErr.Violations))
+	for i, v := range ucErr.Violations {
+		if name, ok := names[v.Field]; ok {
+			v.Field = name
+		}
+		renamed.Violations[i] = v
+	}
+	return &renamed
+}
+
+// AsError returns err as an *Error. Anything that is not already typed is
+// reported as internal so driver details never leak to callers.
+func AsError(err error) *Error {
+	var ucErr *Error
+	if errors.As(err, &ucErr) {
+		return ucErr
+	}
+	return &Error{Code: ErrCodeInternal, Message: "internal error", Err: err}
+}
+
+func wrapRepositoryError(err error) error {
+	switch {
+	case errors.Is(err, repository.ErrConflict):
+		return &Error{Code: ErrCodeConflict, Message: "movie conflicts with an existing record", Err: err}
+	case errors.Is(err, repository.ErrUnavailable):
+		return &Error{Code: ErrCodeUnavailable, Message: "storage is temporarily unavailable", Err: err}
+	}
+	return err
+}
@@ -6,10 +6,13 @@ import (
 	"github.com/sorrawichYooboon/go-protocol-api-style/internal/domain"
 	mockRepo "github.com/sorrawichYooboon/go-protocol-api-style/internal/mock"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 func Test_movieUsecase_getMovieByID(t *testing.T) {
 	mockMovieRepo := mockRepo.NewMovieRepository(t)
+	mockPosterRepo := mockRepo.NewPosterRepository(t)
+	mockMovieEventBus := mockRepo.NewMovieEventBus(t)
 
 	clearAllMock := func() {
 		mockMovieRepo.ClearAll()
@@ -28,7 +31,7 @@ func Test_movieUsecase_getMovieByID(t *testing.T) {
 			name:           "Test should return error when movie repository GetByID returns error",
 			mockServiceReq: 1,
 			wantServiceOrRepoCallWithAndResponse: func() {
-				mockMovieRepo.On("GetByID", int64(1)).Return(nil, assert.AnError)
+				mockMovieRepo.On("GetByID", mock.Anything, int64(1)).Return(nil, assert.AnError)
 			},
 			wantServiceOrRepoCallTimes: map[string]map[string]int{
 				"movieRepository": {
@@ -39,24 +42,24 @@ func Test_movieUsecase_getMovieByID(t *testing.T) {
 			wantMainServiceResponse: nil,
 		},
 		{
-			name:           "Test should return nil when movie repository GetByID returns nil",
+			name:           "Test should return not found error when movie repository GetByID returns nil",
 			mockServiceReq: 2,
 			wantServiceOrRepoCallWithAndResponse: func() {
-				mockMovieRepo.On("GetByID", int64(2)).Return(nil, nil)
+				mockMovieRepo.On("GetByID", mock.Anything, int64(2)).Return(nil, nil)
 			},
 			wantServiceOrRepoCallTimes: map[string]map[string]int{
 				"movieRepository": {
 					"GetByID": 1,
 				},
 			},
-			wantMainServiceError:    nil,
+			wantMainServiceError:    NewNotFoundError("movie 2 not found"),
 			wantMainServiceResponse: nil,
 		},
 		{
 			name:           "Test should return movie when movie repository GetByID returns movie",
 			mockServiceReq: 3,
 			wantServiceOrRepoCallWithAndResponse: func() {
-				mockMovieRepo.On("GetByID", int64(3)).Return(&domain.Movie{ID: 3, Title: "Test Movie"}, nil)
+				mockMovieRepo.On("GetByID", mock.Anything, int64(3)).Return(&domain.Movie{ID: 3, Title: "Test Movie"}, nil)
 			},
 			wantServiceOrRepoCallTimes: map[string]map[string]int{
 				"movieRepository": {
@@ -76,8 +79,8 @@ func Test_movieUsecase_getMovieByID(t *testing.T) {
 				test.wantServiceOrRepoCallWithAndResponse()
 			}
 
-			movieUsecase := NewMovieUsecase(mockMovieRepo)
-			response, err := movieUsecase.GetMovieByID(test.mockServiceReq)
+			movieUsecase := NewMovieUsecase(mockMovieRepo, mockPosterRepo, mockMovieEventBus)
+			response, err := movieUsecase.GetMovieByID(authorizedContext(), test.mockServiceReq)
 
 			if test.wantMainServiceError != nil {
 				assert.Equal(t, test.wantMainServiceError.Error(), err.Error())
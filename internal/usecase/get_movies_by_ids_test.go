@@ -0,0 +1,108 @@
+package usecase
+
+import (
+	"testing"
+
+	"github.com/sorrawichYooboon/go-protocol-api-style/internal/domain"
+	mockRepo "github.com/sorrawichYooboon/go-protocol-api-style/internal/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_movieUsecase_getMoviesByIDs(t *testing.T) {
+	mockMovieRepo := mockRepo.NewMovieRepository(t)
+	mockPosterRepo := mockRepo.NewPosterRepository(t)
+	mockMovieEventBus := mockRepo.NewMovieEventBus(t)
+
+	clearAllMock := func() {
+		mockMovieRepo.ClearAll()
+	}
+
+	tooMany := make([]int64, MaxBatchSize+1)
+
+	tests := []struct {
+		name           string
+		mockServiceReq []int64
+
+		wantServiceOrRepoCallWithAndResponse func()
+		wantServiceOrRepoCallTimes           map[string]map[string]int
+		wantMainServiceError                 error
+		wantMainServiceResponse              map[int64]*domain.Movie
+	}{
+		{
+			name:           "Test should return invalid argument error when more ids than the batch size are requested",
+			mockServiceReq: tooMany,
+			wantServiceOrRepoCallTimes: map[string]map[string]int{
+				"movieRepository": {
+					"GetByIDs": 0,
+				},
+			},
+			wantMainServiceError:    NewInvalidArgumentError("too many ids"),
+			wantMainServiceResponse: nil,
+		},
+		{
+			name:           "Test should return error when movie repository GetByIDs returns error",
+			mockServiceReq: []int64{1, 2},
+			wantServiceOrRepoCallWithAndResponse: func() {
+				mockMovieRepo.On("GetByIDs", mock.Anything, []int64{1, 2}).Return(nil, assert.AnError)
+			},
+			wantServiceOrRepoCallTimes: map[string]map[string]int{
+				"movieRepository": {
+					"GetByIDs": 1,
+				},
+			},
+			wantMainServiceError:    assert.AnError,
+			wantMainServiceResponse: nil,
+		},
+		{
+			name:           "Test should return movies keyed by id and omit missing ids when movie repository GetByIDs returns movies",
+			mockServiceReq: []int64{1, 2, 3},
+			wantServiceOrRepoCallWithAndResponse: func() {
+				mockMovieRepo.On("GetByIDs", mock.Anything, []int64{1, 2, 3}).Return([]domain.Movie{{ID: 3}, {ID: 1}}, nil)
+			},
+			wantServiceOrRepoCallTimes: map[string]map[string]int{
+				"movieRepository": {
+					"GetByIDs": 1,
+				},
+			},
+			wantMainServiceError:    nil,
+			wantMainServiceResponse: map[int64]*domain.Movie{1: {ID: 1}, 3: {ID: 3}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			defer clearAllMock()
+
+			if test.wantServiceOrRepoCallWithAndResponse != nil {
+				test.wantServiceOrRepoCallWithAndResponse()
+			}
+
+			movieUsecase := NewMovieUsecase(mockMovieRepo, mockPosterRepo, mockMovieEventBus)
+			response, err := movieUsecase.GetMoviesByIDs(authorizedContext(), test.mockServiceReq)
+
+			if test.wantMainServiceError != nil {
+				assert.Equal(t, test.wantMainServiceError.Error(), err.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+
+			if test.wantMainServiceResponse != nil {
+				assert.Equal(t, test.wantMainServiceResponse, response)
+			} else {
+				assert.Nil(t, response)
+			}
+
+			for serviceName, serviceCallTimes := range test.wantServiceOrRepoCallTimes {
+				for methodName, times := range serviceCallTimes {
+					switch serviceName {
+					case "movieRepository":
+						mockMovieRepo.AssertNumberOfCalls(t, methodName, times)
+					default:
+						t.Errorf("service %s or method %s not found", serviceName, methodName)
+					}
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,124 @@
+package usecase
+
+import (
+	"testing"
+
+	"github.com/sorrawichYooboon/go-protocol-api-style/internal/domain"
+	mockRepo "github.com/sorrawichYooboon/go-protocol-api-style/internal/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_posterUsecase_getPoster(t *testing.T) {
+	mockMovieRepo := mockRepo.NewMovieRepository(t)
+	mockPosterRepo := mockRepo.NewPosterRepository(t)
+
+	clearAllMock := func() {
+		mockMovieRepo.ClearAll()
+		mockPosterRepo.ClearAll()
+	}
+
+	tests := []struct {
+		name           string
+		mockServiceReq int64
+
+		wantServiceOrRepoCallWithAndResponse func()
+		wantServiceOrRepoCallTimes           map[string]map[string]int
+		wantMainServiceError                 error
+		wantMainServiceResponse              interface{}
+	}{
+		{
+			name:           "Test should return not found error when movie repository GetByID returns nil",
+			mockServiceReq: 1,
+			wantServiceOrRepoCallWithAndResponse: func() {
+				mockMovieRepo.On("GetByID", mock.Anything, int64(1)).Return(nil, nil)
+			},
+			wantServiceOrRepoCallTimes: map[string]map[string]int{
+				"movieRepository":  {"GetByID": 1},
+				"posterRepository": {"Get": 0},
+			},
+			wantMainServiceError:    NewNotFoundError("movie 1 not found"),
+			wantMainServiceResponse: nil,
+		},
+		{
+			name:           "Test should return error when poster repository Get returns error",
+			mockServiceReq: 1,
+			wantServiceOrRepoCallWithAndResponse: func() {
+				mockMovieRepo.On("GetByID", mock.Anything, int64(1)).Return(&domain.Movie{ID: 1}, nil)
+				mockPosterRepo.On("Get", mock.Anything, int64(1)).Return(nil, assert.AnError)
+			},
+			wantServiceOrRepoCallTimes: map[string]map[string]int{
+				"movieRepository":  {"GetByID": 1},
+				"posterRepository": {"Get": 1},
+			},
+			wantMainServiceError:    assert.AnError,
+			wantMainServiceResponse: nil,
+		},
+		{
+			name:           "Test should return not found error when poster repository Get returns nil",
+			mockServiceReq: 2,
+			wantServiceOrRepoCallWithAndResponse: func() {
+				mockMovieRepo.On("GetByID", mock.Anything, int64(2)).Return(&domain.Movie{ID: 2}, nil)
+				mockPosterRepo.On("Get", mock.Anything, int64(2)).Return(nil, nil)
+			},
+			wantServiceOrRepoCallTimes: map[string]map[string]int{
+				"movieRepository":  {"GetByID": 1},
+				"posterRepository": {"Get": 1},
+			},
+			wantMainServiceError:    NewNotFoundError("poster for movie 2 not found"),
+			wantMainServiceResponse: nil,
+		},
+		{
+			name:           "Test should return poster when poster repository Get returns poster",
+			mockServiceReq: 3,
+			wantServiceOrRepoCallWithAndResponse: func() {
+				mockMovieRepo.On("GetByID", mock.Anything, int64(3)).Return(&domain.Movie{ID: 3}, nil)
+				mockPosterRepo.On("Get", mock.Anything, int64(3)).Return(&domain.Poster{MovieID: 3, ContentType: "image/png", Data: testPNG}, nil)
+			},
+			wantServiceOrRepoCallTimes: map[string]map[string]int{
+				"movieRepository":  {"GetByID": 1},
+				"posterRepository": {"Get": 1},
+			},
+			wantMainServiceError:    nil,
+			wantMainServiceResponse: &domain.Poster{MovieID: 3, ContentType: "image/png", Data: testPNG},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			defer clearAllMock()
+
+			if test.wantServiceOrRepoCallWithAndResponse != nil {
+				test.wantServiceOrRepoCallWithAndResponse()
+			}
+
+			posterUsecase := NewPosterUsecase(mockMovieRepo, mockPosterRepo)
+			response, err := posterUsecase.GetPoster(authorizedContext(), test.mockServiceReq)
+
+			if test.wantMainServiceError != nil {
+				assert.Equal(t, test.wantMainServiceError.Error(), err.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+
+			if test.wantMainServiceResponse != nil {
+				assert.Equal(t, test.wantMainServiceResponse, response)
+			} else {
+				assert.Nil(t, response)
+			}
+
+			for serviceName, serviceCallTimes := range test.wantServiceOrRepoCallTimes {
+				for methodName, times := range serviceCallTimes {
+					switch serviceName {
+					case "movieRepository":
+						mockMovieRepo.AssertNumberOfCalls(t, methodName, times)
+					case "posterRepository":
+						mockPosterRepo.AssertNumberOfCalls(t, methodName, times)
+					default:
+						t.Errorf("service %s or method %s not found", serviceName, methodName)
+					}
+				}
+			}
+		})
+	}
+}
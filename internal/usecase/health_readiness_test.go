@@ -0,0 +1,159 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sorrawichYooboon/go-protocol-api-style/internal/domain"
+	mockRepo "github.com/sorrawichYooboon/go-protocol-api-style/internal/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_healthUsecase_readiness(t *testing.T) {
+	mockHealthRepo := mockRepo.NewHealthRepository(t)
+
+	clearAllMock := func() {
+		mockHealthRepo.ClearAll()
+	}
+
+	tests := []struct {
+		name                 string
+		mockMigrationVersion uint
+
+		wantServiceOrRepoCallWithAndResponse func()
+		wantServiceOrRepoCallTimes           map[string]map[string]int
+		wantMainServiceResponse              *domain.HealthReport
+	}{
+		{
+			name:                 "Test should report down when health repository Ping returns error",
+			mockMigrationVersion: 1,
+			wantServiceOrRepoCallWithAndResponse: func() {
+				mockHealthRepo.On("Ping", mock.Anything).Return(assert.AnError)
+			},
+			wantServiceOrRepoCallTimes: map[string]map[string]int{
+				"healthRepository": {
+					"Ping":             1,
+					"MigrationVersion": 0,
+				},
+			},
+			wantMainServiceResponse: &domain.HealthReport{
+				Status: domain.HealthStatusDown,
+				Checks: []domain.HealthCheck{
+					{Name: "database", Status: domain.HealthStatusDown, Error: "unavailable"},
+					{Name: "migrations", Status: domain.HealthStatusDown, Error: "database unavailable"},
+				},
+			},
+		},
+		{
+			name:                 "Test should report down without the error text when health repository MigrationVersion returns error",
+			mockMigrationVersion: 1,
+			wantServiceOrRepoCallWithAndResponse: func() {
+				mockHealthRepo.On("Ping", mock.Anything).Return(nil)
+				mockHealthRepo.On("MigrationVersion", mock.Anything).Return(uint(0), false, assert.AnError)
+			},
+			wantServiceOrRepoCallTimes: map[string]map[string]int{
+				"healthRepository": {
+					"Ping":             1,
+					"MigrationVersion": 1,
+				},
+			},
+			wantMainServiceResponse: &domain.HealthReport{
+				Status: domain.HealthStatusDown,
+				Checks: []domain.HealthCheck{
+					{Name: "database", Status: domain.HealthStatusUp},
+					{Name: "migrations", Status: domain.HealthStatusDown, Error: "unavailable"},
+				},
+			},
+		},
+		{
+			name:                 "Test should report down when migration is dirty",
+			mockMigrationVersion: 1,
+			wantServiceOrRepoCallWithAndResponse: func() {
+				mockHealthRepo.On("Ping", mock.Anything).Return(nil)
+				mockHealthRepo.On("MigrationVersion", mock.Anything).Return(uint(1), true, nil)
+			},
+			wantServiceOrRepoCallTimes: map[string]map[string]int{
+				"healthRepository": {
+					"Ping":             1,
+					"MigrationVersion": 1,
+				},
+			},
+			wantMainServiceResponse: &domain.HealthReport{
+				Status: domain.HealthStatusDown,
+				Checks: []domain.HealthCheck{
+					{Name: "database", Status: domain.HealthStatusUp},
+					{Name: "migrations", Status: domain.HealthStatusDown, Error: "migration 1 is dirty"},
+				},
+			},
+		},
+		{
+			name:                 "Test should report down when database is behind expected migration",
+			mockMigrationVersion: 2,
+			wantServiceOrRepoCallWithAndResponse: func() {
+				mockHealthRepo.On("Ping", mock.Anything).Return(nil)
+				mockHealthRepo.On("MigrationVersion", mock.Anything).Return(uint(1), false, nil)
+			},
+			wantServiceOrRepoCallTimes: map[string]map[string]int{
+				"healthRepository": {
+					"Ping":             1,
+					"MigrationVersion": 1,
+				},
+			},
+			wantMainServiceResponse: &domain.HealthReport{
+				Status: domain.HealthStatusDown,
+				Checks: []domain.HealthCheck{
+					{Name: "database", Status: domain.HealthStatusUp},
+					{Name: "migrations", Status: domain.HealthStatusDown, Error: "database at migration 1, want 2"},
+				},
+			},
+		},
+		{
+			name:                 "Test should report up when database is reachable and migrated",
+			mockMigrationVersion: 1,
+			wantServiceOrRepoCallWithAndResponse: func() {
+				mockHealthRepo.On("Ping", mock.Anything).Return(nil)
+				mockHealthRepo.On("MigrationVersion", mock.Anything).Return(uint(1), false, nil)
+			},
+			wantServiceOrRepoCallTimes: map[string]map[string]int{
+				"healthRepository": {
+					"Ping":             1,
+					"MigrationVersion": 1,
+				},
+			},
+			wantMainServiceResponse: &domain.HealthReport{
+				Status: domain.HealthStatusUp,
+				Checks: []domain.HealthCheck{
+					{Name: "database", Status: domain.HealthStatusUp},
+					{Name: "migrations", Status: domain.HealthStatusUp},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			defer clearAllMock()
+
+			if test.wantServiceOrRepoCallWithAndResponse != nil {
+				test.wantServiceOrRepoCallWithAndResponse()
+			}
+
+			healthUsecase := NewHealthUsecase(mockHealthRepo, test.mockMigrationVersion)
+			response := healthUsecase.Readiness(context.Background())
+
+			assert.Equal(t, test.wantMainServiceResponse, response)
+
+			for serviceName, serviceCallTimes := range test.wantServiceOrRepoCallTimes {
+				for methodName, times := range serviceCallTimes {
+					switch serviceName {
+					case "healthRepository":
+						mockHealthRepo.AssertNumberOfCalls(t, methodName, times)
+					default:
+						t.Errorf("service %s or method %s not found", serviceName, methodName)
+					}
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/sorrawichYooboon/go-protocol-api-style/internal/domain"
+	"github.com/sorrawichYooboon/go-protocol-api-style/internal/repository"
+	"github.com/sorrawichYooboon/go-protocol-api-style/logger"
+)
+
+const healthCheckTimeout = 2 * time.Second
+
+type HealthUsecaseImpl struct {
+	healthRepo       repository.HealthRepository
+	migrationVersion uint
+}
+
+// NewHealthUsecase expects the database to be migrated to migrationVersion
+// before the service reports itself ready.
+func NewHealthUsecase(repo repository.HealthRepository, migrationVersion uint) HealthUsecase {
+	return &HealthUsecaseImpl{healthRepo: repo, migrationVersion: migrationVersion}
+}
+
+func (u *HealthUsecaseImpl) Liveness(ctx context.Context) *domain.HealthReport {
+	return &domain.HealthReport{Status: domain.HealthStatusUp, Checks: []domain.HealthCheck{}}
+}
+
+func (u *HealthUsecaseImpl) Readiness(ctx context.Context) *domain.HealthReport {
+	ctx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+	defer cancel()
+
+	report := &domain.HealthReport{Status: domain.HealthStatusUp}
+
+	database := domain.HealthCheck{Name: "database", Status: domain.HealthStatusUp}
+	if err := u.healthRepo.Ping(ctx); err != nil {
+		// The report is served unauthenticated, so driver errors, which can
+		// name hosts and users, are only logged.
+		logger.LogError("HealthUsecase.Readiness", err, map[string]any{"check": "database"})
+		database.Status = domain.HealthStatusDown
+		database.Error = "unavailable"
+	}
+	report.Checks = append(report.Checks, database)
+
+	migrations := domain.HealthCheck{Name: "migrations", Status: domain.HealthStatusUp}
+	if database.Status == domain.HealthStatusDown {
+		migrations.Status = domain.HealthStatusDown
+		migrations.Error = "database unavailable"
+	} else if version, dirty, err := u.healthRepo.MigrationVersion(ctx); err != nil {
+		logger.LogError("HealthUsecase.Readiness", err, map[string]any{"check": "migrations"})
+		migrations.Status = domain.HealthStatusDown
+		migrations.Error = "unavailable"
+	} else if dirty {
+		migrations.Status = domain.HealthStatusDown
+		migrations.Error = fmt.Sprintf("migration %d is dirty", version)
+	} else if version != u.migrationVersion {
+		migrations.Status = domain.HealthStatusDown
+		migrations.Error = fmt.Sprintf("database at migration %d, want %d", version, u.migrationVersion)
+	}
+	report.Checks = append(report.Checks, migrations)
+
+	for _, check := range report.Checks {
+		if check.Status == domain.HealthStatusDown {
+			report.Status = domain.HealthStatusDown
+		}
+	}
+	return report
+}
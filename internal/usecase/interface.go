@@ -1,8 +1,36 @@
 package usecase
 
-import "github.com/sorrawichYooboon/go-protocol-api-style/internal/domain"
+import (
+	"context"
+
+	"github.com/sorrawichYooboon/go-protocol-api-style/internal/domain"
+)
 
 type MovieUsecase interface {
-	GetAllMovies() ([]domain.Movie, error)
-	GetMovieByID(id int64) (*domain.Movie, error)
+	GetAllMovies(ctx context.Context) ([]domain.Movie, error)
+	ListMovies(ctx context.Context, filter domain.MovieFilter, sort domain.MovieSort, pageSize int, cursor string) (*domain.MoviePage, error)
+	SearchMovies(ctx context.Context, query string, pageSize int, cursor string) (*domain.MoviePage, error)
+	StreamMovies(ctx context.Context, send func(*domain.Movie) error) error
+	GetMovieByID(ctx context.Context, id int64) (*domain.Movie, error)
+	GetMoviesByIDs(ctx context.Context, ids []int64) (map[int64]*domain.Movie, error)
+	CreateMovie(ctx context.Context, movie *domain.Movie) (*domain.Movie, error)
+	UpdateMovie(ctx context.Context, movie *domain.Movie) (*domain.Movie, error)
+	DeleteMovie(ctx context.Context, id int64) (*domain.Movie, error)
+	SubscribeMovieChanges(ctx context.Context) (<-chan domain.MovieEvent, error)
+}
+
+type PosterUsecase interface {
+	UploadPoster(ctx context.Context, movieID int64, data []byte) (*domain.Poster, error)
+	GetPoster(ctx context.Context, movieID int64) (*domain.Poster, error)
+}
+
+type HealthUsecase interface {
+	Liveness(ctx context.Context) *domain.HealthReport
+	Readiness(ctx context.Context) *domain.HealthReport
+}
+
+type AuthUsecase interface {
+	AuthenticateAPIKey(ctx context.Context, key string) (*domain.Principal, error)
+	IssueAPIKey(ctx context.Context, name string, scopes []string) (string, *domain.APIKey, error)
+	RevokeAPIKey(ctx context.Context, id int64) (*domain.APIKey, error)
 }
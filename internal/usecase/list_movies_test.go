@@ -0,0 +1,248 @@
+package usecase
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sorrawichYooboon/go-protocol-api-style/internal/domain"
+	mockRepo "github.com/sorrawichYooboon/go-protocol-api-style/internal/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type listMoviesReq struct {
+	filter   domain.MovieFilter
+	sort     domain.MovieSort
+	pageSize int
+	cursor   string
+}
+
+func Test_movieUsecase_listMovies(t *testing.T) {
+	mockMovieRepo := mockRepo.NewMovieRepository(t)
+	mockPosterRepo := mockRepo.NewPosterRepository(t)
+	mockMovieEventBus := mockRepo.NewMovieEventBus(t)
+
+	clearAllMock := func() {
+		mockMovieRepo.ClearAll()
+	}
+
+	dune := domain.Movie{ID: 4, Title: "Dune", ReleaseDate: domain.Date{Year: 2021, Month: time.October, Day: 22}}
+	arrival := domain.Movie{ID: 7, Title: "Arrival", ReleaseDate: domain.Date{Year: 2016, Month: time.November, Day: 11}}
+	titleDesc := domain.MovieSort{Field: domain.MovieSortByTitle, Descending: true}
+
+	tests := []struct {
+		name           string
+		mockServiceReq listMoviesReq
+
+		wantServiceOrRepoCallWithAndResponse func()
+		wantServiceOrRepoCallTimes           map[string]map[string]int
+		wantMainServiceError                 error
+		wantMainServiceResponse              interface{}
+	}{
+		{
+			name:           "Test should return error when cursor is invalid",
+			mockServiceReq: listMoviesReq{pageSize: 2, cursor: "not-a-cursor"},
+			wantServiceOrRepoCallTimes: map[string]map[string]int{
+				"movieRepository": {
+					"List": 0,
+				},
+			},
+			wantMainServiceError:    ErrInvalidCursor,
+			wantMainServiceResponse: nil,
+		},
+		{
+			name:           "Test should return error when movie repository List returns error",
+			mockServiceReq: listMoviesReq{pageSize: 2},
+			wantServiceOrRepoCallWithAndResponse: func() {
+				mockMovieRepo.On("List", mock.Anything, domain.MovieFilter{}, domain.MovieSort{}, 3, (*domain.Movie)(nil)).Return(nil, assert.AnError)
+			},
+			wantServiceOrRepoCallTimes: map[string]map[string]int{
+				"movieRepository": {
+					"List": 1,
+				},
+			},
+			wantMainServiceError:    assert.AnError,
+			wantMainServiceResponse: nil,
+		},
+		{
+			name:           "Test should return next cursor when movie repository List returns more than page size",
+			mockServiceReq: listMoviesReq{pageSize: 2},
+			wantServiceOrRepoCallWithAndResponse: func() {
+				mockMovieRepo.On("List", mock.Anything, domain.MovieFilter{}, domain.MovieSort{}, 3, (*domain.Movie)(nil)).Return([]domain.Movie{{ID: 1}, {ID: 2}, {ID: 3}}, nil)
+			},
+			wantServiceOrRepoCallTimes: map[string]map[string]int{
+				"movieRepository": {
+					"List": 1,
+				},
+			},
+			wantMainServiceError: nil,
+			wantMainServiceResponse: &domain.MoviePage{
+				Edges: []domain.MovieEdge{
+					{Movie: domain.Movie{ID: 1}, Cursor: encodeMovieCursor(domain.Movie{ID: 1}, domain.MovieSort{})},
+					{Movie: domain.Movie{ID: 2}, Cursor: encodeMovieCursor(domain.Movie{ID: 2}, domain.MovieSort{})},
+				},
+				NextCursor: encodeMovieCursor(domain.Movie{ID: 2}, domain.MovieSort{}),
+			},
+		},
+		{
+			name:           "Test should resume after cursor and clamp page size when movie repository List returns last page",
+			mockServiceReq: listMoviesReq{pageSize: MaxPageSize + 1, cursor: encodeMovieCursor(domain.Movie{ID: 2}, domain.MovieSort{})},
+			wantServiceOrRepoCallWithAndResponse: func() {
+				mockMovieRepo.On("List", mock.Anything, domain.MovieFilter{}, domain.MovieSort{}, MaxPageSize+1, &domain.Movie{ID: 2}).Return([]domain.Movie{{ID: 3}}, nil)
+			},
+			wantServiceOrRepoCallTimes: map[string]map[string]int{
+				"movieRepository": {
+					"List": 1,
+				},
+			},
+			wantMainServiceError: nil,
+			wantMainServiceResponse: &domain.MoviePage{
+				Edges: []domain.MovieEdge{
+					{Movie: domain.Movie{ID: 3}, Cursor: encodeMovieCursor(domain.Movie{ID: 3}, domain.MovieSort{})},
+				},
+			},
+		},
+		{
+			name: "Test should return invalid argument error when filter is invalid",
+			mockServiceReq: listMoviesReq{filter: domain.MovieFilter{
+				TitleContains: strings.Repeat("a", 256),
+				ReleasedFrom:  domain.Date{Year: 2021, Month: time.February, Day: 30},
+			}},
+			wantServiceOrRepoCallTimes: map[string]map[string]int{
+				"movieRepository": {
+					"List": 0,
+				},
+			},
+			wantMainServiceError: NewInvalidArgumentError("invalid filter",
+				FieldViolation{Field: "titleContains", Description: "must be at most 255 characters"},
+				FieldViolation{Field: "releasedFrom", Description: "must be a valid date between 0001-01-01 and 9999-12-31"},
+			),
+			wantMainServiceResponse: nil,
+		},
+		{
+			name: "Test should return invalid argument error when release date range is reversed",
+			mockServiceReq: listMoviesReq{filter: domain.MovieFilter{
+				ReleasedFrom: domain.Date{Year: 2022, Month: time.January, Day: 1},
+				ReleasedTo:   domain.Date{Year: 2021, Month: time.January, Day: 1},
+			}},
+			wantServiceOrRepoCallTimes: map[string]map[string]int{
+				"movieRepository": {
+					"List": 0,
+				},
+			},
+			wantMainServiceError: NewInvalidArgumentError("invalid filter",
+				FieldViolation{Field: "releasedFrom", Description: "must not be after the end of the date range"},
+			),
+			wantMainServiceResponse: nil,
+		},
+		{
+			name:           "Test should return invalid argument error when sort field is unknown",
+			mockServiceReq: listMoviesReq{sort: domain.MovieSort{Field: "RATING"}},
+			wantServiceOrRepoCallTimes: map[string]map[string]int{
+				"movieRepository": {
+					"List": 0,
+				},
+			},
+			wantMainServiceError: NewInvalidArgumentError("invalid sort",
+				FieldViolation{Field: "sort", Description: "is not a supported sort field"},
+			),
+			wantMainServiceResponse: nil,
+		},
+		{
+			name:           "Test should return error when cursor was returned for a different sort",
+			mockServiceReq: listMoviesReq{sort: titleDesc, cursor: encodeMovieCursor(dune, domain.MovieSort{Field: domain.MovieSortByTitle})},
+			wantServiceOrRepoCallTimes: map[string]map[string]int{
+				"movieRepository": {
+					"List": 0,
+				},
+			},
+			wantMainServiceError:    ErrInvalidCursor,
+			wantMainServiceResponse: nil,
+		},
+		{
+			name:           "Test should list in default order when sort is id ascending",
+			mockServiceReq: listMoviesReq{sort: domain.MovieSort{Field: domain.MovieSortByID}, pageSize: 2},
+			wantServiceOrRepoCallWithAndResponse: func() {
+				mockMovieRepo.On("List", mock.Anything, domain.MovieFilter{}, domain.MovieSort{}, 3, (*domain.Movie)(nil)).Return([]domain.Movie{dune}, nil)
+			},
+			wantServiceOrRepoCallTimes: map[string]map[string]int{
+				"movieRepository": {
+					"List": 1,
+				},
+			},
+			wantMainServiceError: nil,
+			wantMainServiceResponse: &domain.MoviePage{
+				Edges: []domain.MovieEdge{
+					{Movie: dune, Cursor: encodeMovieCursor(dune, domain.MovieSort{})},
+				},
+			},
+		},
+		{
+			name: "Test should filter and resume after sort key when movie repository List returns more than page size",
+			mockServiceReq: listMoviesReq{
+				filter:   domain.MovieFilter{TitleContains: " a ", ReleasedFrom: domain.Date{Year: 2010, Month: time.January, Day: 1}},
+				sort:     titleDesc,
+				pageSize: 1,
+				cursor:   encodeMovieCursor(domain.Movie{ID: 9, Title: "Interstellar"}, titleDesc),
+			},
+			wantServiceOrRepoCallWithAndResponse: func() {
+				mockMovieRepo.On("List", mock.Anything,
+					domain.MovieFilter{TitleContains: "a", ReleasedFrom: domain.Date{Year: 2010, Month: time.January, Day: 1}},
+					titleDesc, 2, &domain.Movie{ID: 9, Title: "Interstellar"},
+				).Return([]domain.Movie{dune, arrival}, nil)
+			},
+			wantServiceOrRepoCallTimes: map[string]map[string]int{
+				"movieRepository": {
+					"List": 1,
+				},
+			},
+			wantMainServiceError: nil,
+			wantMainServiceResponse: &domain.MoviePage{
+				Edges: []domain.MovieEdge{
+					{Movie: dune, Cursor: encodeMovieCursor(dune, titleDesc)},
+				},
+				NextCursor: encodeMovieCursor(dune, titleDesc),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			defer clearAllMock()
+
+			if test.wantServiceOrRepoCallWithAndResponse != nil {
+				test.wantServiceOrRepoCallWithAndResponse()
+			}
+
+			movieUsecase := NewMovieUsecase(mockMovieRepo, mockPosterRepo, mockMovieEventBus)
+			response, err := movieUsecase.ListMovies(authorizedContext(), test.mockServiceReq.filter, test.mockServiceReq.sort, test.mockServiceReq.pageSize, test.mockServiceReq.cursor)
+
+			if test.wantMainServiceError != nil {
+				assert.Equal(t, test.wantMainServiceError.Error(), err.Error())
+				if wantErr := AsError(test.wantMainServiceError); wantErr.Code == ErrCodeInvalidArgument {
+					assert.Equal(t, wantErr.Violations, AsError(err).Violations)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+
+			if test.wantMainServiceResponse != nil {
+				assert.Equal(t, test.wantMainServiceResponse, response)
+			} else {
+				assert.Nil(t, response)
+			}
+
+			for serviceName, serviceCallTimes := range test.wantServiceOrRepoCallTimes {
+				for methodName, times := range serviceCallTimes {
+					switch serviceName {
+					case "movieRepository":
+						mockMovieRepo.AssertNumberOfCalls(t, methodName, times)
+					default:
+						t.Errorf("service %s or method %s not found", serviceName, methodName)
+					}
+				}
+			}
+		})
+	}
+}
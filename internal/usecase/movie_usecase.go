@@ -1,29 +1,243 @@
 package usecase
 
 import (
+	"context"
+	"strings"
+	"unicode/utf8"
+
 	"github.com/sorrawichYooboon/go-protocol-api-style/internal/domain"
 	"github.com/sorrawichYooboon/go-protocol-api-style/internal/repository"
+	"github.com/sorrawichYooboon/go-protocol-api-style/logger"
 )
 
+// MaxBatchSize bounds GetMoviesByIDs, like MaxPageSize bounds a page.
+const MaxBatchSize = 100
+
 type MovieUsecaseImpl struct {
-	movieRepo repository.MovieRepository
+	movieRepo   repository.MovieRepository
+	posterRepo  repository.PosterRepository
+	movieEvents repository.MovieEventBus
 }
 
-func NewMovieUsecase(repo repository.MovieRepository) MovieUsecase {
-	return &MovieUsecaseImpl{movieRepo: repo}
+// NewMovieUsecase accepts a nil posters for deployments without poster
+// storage; deleting a movie then has no poster to clean up.
+func NewMovieUsecase(repo repository.MovieRepository, posters repository.PosterRepository, events repository.MovieEventBus) MovieUsecase {
+	return &MovieUsecaseImpl{movieRepo: repo, posterRepo: posters, movieEvents: events}
 }
 
-func (u *MovieUsecaseImpl) GetAllMovies() ([]domain.Movie, error) {
-	return u.movieRepo.GetAll()
+func (u *MovieUsecaseImpl) GetAllMovies(ctx context.Context) ([]domain.Movie, error) {
+	if err := authorize(ctx, ScopeMoviesRead); err != nil {
+		return nil, err
+	}
+	movies, err := u.movieRepo.GetAll(ctx)
+	if err != nil {
+		return nil, wrapRepositoryError(err)
+	}
+	return movies, nil
 }
 
-func (u *MovieUsecaseImpl) GetMovieByID(id int64) (*domain.Movie, error) {
-	movie, err := u.movieRepo.GetByID(id)
+func (u *MovieUsecaseImpl) ListMovies(ctx context.Context, filter domain.MovieFilter, sort domain.MovieSort, pageSize int, cursor string) (*domain.MoviePage, error) {
+	if err := authorize(ctx, ScopeMoviesRead); err != nil {
+		return nil, err
+	}
+	if pageSize < 0 {
+		return nil, NewInvalidArgumentError("invalid page size", FieldViolation{Field: "pageSize", Description: "must not be negative"})
+	}
+	filter.TitleContains = strings.TrimSpace(filter.TitleContains)
+	if err := validateMovieFilter(filter); err != nil {
+		return nil, err
+	}
+	sort, err := normalizeMovieSort(sort)
+	if err != nil {
+		return nil, err
+	}
+	after, err := decodeMovieCursor(cursor, sort)
+	if err != nil {
+		return nil, err
+	}
+	pageSize = normalizePageSize(pageSize)
+
+	// Fetch one extra row to know whether another page exists.
+	movies, err := u.movieRepo.List(ctx, filter, sort, pageSize+1, after)
 	if err != nil {
+		return nil, wrapRepositoryError(err)
+	}
+	return newMoviePage(movies, pageSize, sort), nil
+}
+
+func (u *MovieUsecaseImpl) SearchMovies(ctx context.Context, query string, pageSize int, cursor string) (*domain.MoviePage, error) {
+	if err := authorize(ctx, ScopeMoviesRead); err != nil {
 		return nil, err
 	}
+	query = strings.TrimSpace(query)
+	var violations []FieldViolation
+	switch {
+	case query == "":
+		violations = append(violations, FieldViolation{Field: "query", Description: "must not be empty"})
+	case utf8.RuneCountInString(query) > maxSearchQueryLength:
+		violations = append(violations, FieldViolation{Field: "query", Description: "must be at most 255 characters"})
+	}
+	if pageSize < 0 {
+		violations = append(violations, FieldViolation{Field: "pageSize", Description: "must not be negative"})
+	}
+	if len(violations) > 0 {
+		return nil, NewInvalidArgumentError("invalid search", violations...)
+	}
+	after, err := decodeMovieCursor(cursor, domain.MovieSort{})
+	if err != nil {
+		return nil, err
+	}
+	var afterID int64
+	if after != nil {
+		afterID = after.ID
+	}
+	pageSize = normalizePageSize(pageSize)
+
+	movies, err := u.movieRepo.Search(ctx, query, pageSize+1, afterID)
+	if err != nil {
+		return nil, wrapRepositoryError(err)
+	}
+	return newMoviePage(movies, pageSize, domain.MovieSort{}), nil
+}
+
+// newMoviePage trims movies, fetched with one extra row, to pageSize and sets
+// NextCursor when the extra row was present.
+func newMoviePage(movies []domain.Movie, pageSize int, sort domain.MovieSort) *domain.MoviePage {
+	page := &domain.MoviePage{}
+	if len(movies) > pageSize {
+		movies = movies[:pageSize]
+		page.NextCursor = encodeMovieCursor(movies[len(movies)-1], sort)
+	}
+	page.Edges = make([]domain.MovieEdge, 0, len(movies))
+	for _, m := range movies {
+		page.Edges = append(page.Edges, domain.MovieEdge{
+			Movie:  m,
+			Cursor: encodeMovieCursor(m, sort),
+		})
+	}
+	return page
+}
+
+func (u *MovieUsecaseImpl) StreamMovies(ctx context.Context, send func(*domain.Movie) error) error {
+	if err := authorize(ctx, ScopeMoviesRead); err != nil {
+		return err
+	}
+	it, err := u.movieRepo.Iterate(ctx)
+	if err != nil {
+		return wrapRepositoryError(err)
+	}
+	defer it.Close()
+
+	for it.Next() {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		movie, err := it.Movie()
+		if err != nil {
+			return wrapRepositoryError(err)
+		}
+		if err := send(movie); err != nil {
+			return err
+		}
+	}
+	return wrapRepositoryError(it.Err())
+}
+
+func (u *MovieUsecaseImpl) GetMovieByID(ctx context.Context, id int64) (*domain.Movie, error) {
+	if err := authorize(ctx, ScopeMoviesRead); err != nil {
+		return nil, err
+	}
+	movie, err := u.movieRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, wrapRepositoryError(err)
+	}
 	if movie == nil {
-		return nil, nil
+		return nil, NewNotFoundError("movie %d not found", id)
 	}
 	return movie, nil
 }
+
+// GetMoviesByIDs looks up many movies in one repository call. Movies that do
+// not exist are absent from the result.
+func (u *MovieUsecaseImpl) GetMoviesByIDs(ctx context.Context, ids []int64) (map[int64]*domain.Movie, error) {
+	if err := authorize(ctx, ScopeMoviesRead); err != nil {
+		return nil, err
+	}
+	if len(ids) > MaxBatchSize {
+		return nil, NewInvalidArgumentError("too many ids", FieldViolation{Field: "ids", Description: "must contain at most 100 ids"})
+	}
+	movies, err := u.movieRepo.GetByIDs(ctx, ids)
+	if err != nil {
+		return nil, wrapRepositoryError(err)
+	}
+	result := make(map[int64]*domain.Movie, len(movies))
+	for i := range movies {
+		result[movies[i].ID] = &movies[i]
+	}
+	return result, nil
+}
+
+func (u *MovieUsecaseImpl) CreateMovie(ctx context.Context, movie *domain.Movie) (*domain.Movie, error) {
+	if err := authorize(ctx, ScopeMoviesWrite); err != nil {
+		return nil, err
+	}
+	if err := validateMovie(movie); err != nil {
+		return nil, err
+	}
+	created, err := u.movieRepo.Create(ctx, movie)
+	if err != nil {
+		return nil, wrapRepositoryError(err)
+	}
+	u.movieEvents.Publish(domain.MovieEvent{Type: domain.MovieCreated, Movie: *created})
+	return created, nil
+}
+
+func (u *MovieUsecaseImpl) UpdateMovie(ctx context.Context, movie *domain.Movie) (*domain.Movie, error) {
+	if err := authorize(ctx, ScopeMoviesWrite); err != nil {
+		return nil, err
+	}
+	if err := validateMovie(movie); err != nil {
+		return nil, err
+	}
+	updated, err := u.movieRepo.Update(ctx, movie)
+	if err != nil {
+		return nil, wrapRepositoryError(err)
+	}
+	if updated == nil {
+		return nil, NewNotFoundError("movie %d not found", movie.ID)
+	}
+	u.movieEvents.Publish(domain.MovieEvent{Type: domain.MovieUpdated, Movie: *updated})
+	return updated, nil
+}
+
+func (u *MovieUsecaseImpl) DeleteMovie(ctx context.Context, id int64) (*domain.Movie, error) {
+	if err := authorize(ctx, ScopeMoviesWrite); err != nil {
+		return nil, err
+	}
+	deleted, err := u.movieRepo.Delete(ctx, id)
+	if err != nil {
+		return nil, wrapRepositoryError(err)
+	}
+	if deleted == nil {
+		return nil, NewNotFoundError("movie %d not found", id)
+	}
+	// The movie is already gone and GetPoster checks for it, so a poster
+	// that cannot be removed is unreachable; it is logged rather than
+	// failing a delete that has happened.
+	if u.posterRepo != nil {
+		if err := u.posterRepo.Delete(ctx, id); err != nil {
+			logger.LogError("MovieUsecase.DeleteMovie", err, map[string]any{"movieId": id})
+		}
+	}
+	u.movieEvents.Publish(domain.MovieEvent{Type: domain.MovieDeleted, Movie: *deleted})
+	return deleted, nil
+}
+
+// SubscribeMovieChanges returns the changes made to the catalog from now on.
+// The channel is closed once ctx is done.
+func (u *MovieUsecaseImpl) SubscribeMovieChanges(ctx context.Context) (<-chan domain.MovieEvent, error) {
+	if err := authorize(ctx, ScopeMoviesRead); err != nil {
+		return nil, err
+	}
+	return u.movieEvents.Subscribe(ctx), nil
+}
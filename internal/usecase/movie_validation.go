@@ -0,0 +1,90 @@
+package usecase
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/sorrawichYooboon/go-protocol-api-style/internal/domain"
+)
+
+const (
+	maxMovieTitleLength  = 255
+	maxSearchQueryLength = 255
+)
+
+var ErrInvalidReleaseDate = NewInvalidArgumentError("invalid movie", FieldViolation{Field: "releaseDate", Description: "must be a date in YYYY-MM-DD format"})
+
+// ParseReleaseDate parses a release date sent as text. An empty string gives
+// the zero Date, which validateMovie reports as missing.
+func ParseReleaseDate(s string) (domain.Date, error) {
+	if s == "" {
+		return domain.Date{}, nil
+	}
+	date, err := domain.ParseDate(s)
+	if err != nil {
+		return domain.Date{}, ErrInvalidReleaseDate
+	}
+	return date, nil
+}
+
+func validateMovie(movie *domain.Movie) error {
+	var violations []FieldViolation
+
+	switch {
+	case strings.TrimSpace(movie.Title) == "":
+		violations = append(violations, FieldViolation{Field: "title", Description: "must not be empty"})
+	case utf8.RuneCountInString(movie.Title) > maxMovieTitleLength:
+		violations = append(violations, FieldViolation{Field: "title", Description: "must be at most 255 characters"})
+	}
+
+	switch {
+	case movie.ReleaseDate.IsZero():
+		violations = append(violations, FieldViolation{Field: "releaseDate", Description: "must not be empty"})
+	case !movie.ReleaseDate.IsValid():
+		violations = append(violations, FieldViolation{Field: "releaseDate", Description: "must be a valid date between 0001-01-01 and 9999-12-31"})
+	}
+
+	if len(violations) > 0 {
+		return NewInvalidArgumentError("invalid movie", violations...)
+	}
+	return nil
+}
+
+func validateMovieFilter(filter domain.MovieFilter) error {
+	var violations []FieldViolation
+
+	if utf8.RuneCountInString(filter.TitleContains) > maxSearchQueryLength {
+		violations = append(violations, FieldViolation{Field: "titleContains", Description: "must be at most 255 characters"})
+	}
+	if !filter.ReleasedFrom.IsZero() && !filter.ReleasedFrom.IsValid() {
+		violations = append(violations, FieldViolation{Field: "releasedFrom", Description: "must be a valid date between 0001-01-01 and 9999-12-31"})
+	}
+	if !filter.ReleasedTo.IsZero() && !filter.ReleasedTo.IsValid() {
+		violations = append(violations, FieldViolation{Field: "releasedTo", Description: "must be a valid date between 0001-01-01 and 9999-12-31"})
+	}
+	if len(violations) == 0 && !filter.ReleasedFrom.IsZero() && !filter.ReleasedTo.IsZero() &&
+		filter.ReleasedFrom.Time().After(filter.ReleasedTo.Time()) {
+		violations = append(violations, FieldViolation{Field: "releasedFrom", Description: "must not be after the end of the date range"})
+	}
+
+	if len(violations) > 0 {
+		return NewInvalidArgumentError("invalid filter", violations...)
+	}
+	return nil
+}
+
+// normalizeMovieSort returns the zero MovieSort for id ascending, so default
+// listings keep their short cursors.
+func normalizeMovieSort(sort domain.MovieSort) (domain.MovieSort, error) {
+	switch sort.Field {
+	case "":
+		sort.Field = domain.MovieSortByID
+	case domain.MovieSortByID, domain.MovieSortByTitle, domain.MovieSortByReleaseDate:
+	default:
+		return domain.MovieSort{}, NewInvalidArgumentError("invalid sort", FieldViolation{Field: "sort", Description: "is not a supported sort field"})
+	}
+	if sort == (domain.MovieSort{Field: domain.MovieSortByID}) {
+		return domain.MovieSort{}, nil
+	}
+	return sort, nil
+}
@@ -0,0 +1,80 @@
+package usecase
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/sorrawichYooboon/go-protocol-api-style/internal/domain"
+	"github.com/sorrawichYooboon/go-protocol-api-style/internal/repository"
+)
+
+const MaxPosterSize = 5 << 20
+
+// Only formats http.DetectContentType recognises are accepted, so the stored
+// bytes are enough to recover the content type.
+var posterContentTypes = map[string]bool{
+	"image/png":  true,
+	"image/jpeg": true,
+	"image/gif":  true,
+	"image/webp": true,
+}
+
+type PosterUsecaseImpl struct {
+	movieRepo  repository.MovieRepository
+	posterRepo repository.PosterRepository
+}
+
+func NewPosterUsecase(movieRepo repository.MovieRepository, posterRepo repository.PosterRepository) PosterUsecase {
+	return &PosterUsecaseImpl{movieRepo: movieRepo, posterRepo: posterRepo}
+}
+
+func (u *PosterUsecaseImpl) UploadPoster(ctx context.Context, movieID int64, data []byte) (*domain.Poster, error) {
+	if err := authorize(ctx, ScopeMoviesWrite); err != nil {
+		return nil, err
+	}
+	contentType := http.DetectContentType(data)
+	switch {
+	case len(data) == 0:
+		return nil, NewInvalidArgumentError("invalid poster", FieldViolation{Field: "image", Description: "must not be empty"})
+	case len(data) > MaxPosterSize:
+		return nil, NewInvalidArgumentError("invalid poster", FieldViolation{Field: "image", Description: "must be at most 5 MiB"})
+	case !posterContentTypes[contentType]:
+		return nil, NewInvalidArgumentError("invalid poster", FieldViolation{Field: "image", Description: "must be a PNG, JPEG, GIF or WebP image"})
+	}
+
+	movie, err := u.movieRepo.GetByID(ctx, movieID)
+	if err != nil {
+		return nil, wrapRepositoryError(err)
+	}
+	if movie == nil {
+		return nil, NewNotFoundError("movie %d not found", movieID)
+	}
+
+	poster := &domain.Poster{MovieID: movieID, ContentType: contentType, Data: data}
+	if err := u.posterRepo.Put(ctx, poster); err != nil {
+		return nil, wrapRepositoryError(err)
+	}
+	return poster, nil
+}
+
+func (u *PosterUsecaseImpl) GetPoster(ctx context.Context, movieID int64) (*domain.Poster, error) {
+	if err := authorize(ctx, ScopeMoviesRead); err != nil {
+		return nil, err
+	}
+	movie, err := u.movieRepo.GetByID(ctx, movieID)
+	if err != nil {
+		return nil, wrapRepositoryError(err)
+	}
+	if movie == nil {
+		return nil, NewNotFoundError("movie %d not found", movieID)
+	}
+
+	poster, err := u.posterRepo.Get(ctx, movieID)
+	if err != nil {
+		return nil, wrapRepositoryError(err)
+	}
+	if poster == nil {
+		return nil, NewNotFoundError("poster for movie %d not found", movieID)
+	}
+	return poster, nil
+}
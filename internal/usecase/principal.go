@@ -0,0 +1,19 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/sorrawichYooboon/go-protocol-api-style/internal/domain"
+)
+
+type principalKey struct{}
+
+// WithPrincipal is called by the transports once a request is authenticated.
+func WithPrincipal(ctx context.Context, principal *domain.Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, principal)
+}
+
+func PrincipalFromContext(ctx context.Context) (*domain.Principal, bool) {
+	principal, ok := ctx.Value(principalKey{}).(*domain.Principal)
+	return principal, ok && principal != nil
+}
@@ -0,0 +1,49 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRenameViolations(t *testing.T) {
+	names := map[string]string{"releasedFrom": "released_from", "pageSize": "limit"}
+	notFound := NewNotFoundError("movie %d not found", 7)
+	noViolations := NewInvalidArgumentError("invalid cursor")
+	other := errors.New("boom")
+
+	tests := []struct {
+		name string
+		err  error
+		want error
+	}{
+		{
+			name: "renames known fields and keeps the others",
+			err: NewInvalidArgumentError("invalid filter",
+				FieldViolation{Field: "releasedFrom", Description: "must not be after the end of the date range"},
+				FieldViolation{Field: "title", Description: "must not be empty"},
+			),
+			want: NewInvalidArgumentError("invalid filter",
+				FieldViolation{Field: "released_from", Description: "must not be after the end of the date range"},
+				FieldViolation{Field: "title", Description: "must not be empty"},
+			),
+		},
+		{name: "error without violations", err: noViolations, want: noViolations},
+		{name: "other usecase error", err: notFound, want: notFound},
+		{name: "untyped error", err: other, want: other},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, RenameViolations(tt.err, names))
+		})
+	}
+}
+
+func TestRenameViolations_DoesNotModifySharedErrors(t *testing.T) {
+	renamed := RenameViolations(ErrInvalidReleaseDate, map[string]string{"releaseDate": "release_date"})
+
+	assert.Equal(t, "release_date", AsError(renamed).Violations[0].Field)
+	assert.Equal(t, "releaseDate", ErrInvalidReleaseDate.Violations[0].Field)
+}
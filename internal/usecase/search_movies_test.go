@@ -0,0 +1,128 @@
+package usecase
+
+import (
+	"testing"
+
+	"github.com/sorrawichYooboon/go-protocol-api-style/internal/domain"
+	mockRepo "github.com/sorrawichYooboon/go-protocol-api-style/internal/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type searchMoviesReq struct {
+	query    string
+	pageSize int
+	cursor   string
+}
+
+func Test_movieUsecase_searchMovies(t *testing.T) {
+	mockMovieRepo := mockRepo.NewMovieRepository(t)
+	mockPosterRepo := mockRepo.NewPosterRepository(t)
+	mockMovieEventBus := mockRepo.NewMovieEventBus(t)
+
+	clearAllMock := func() {
+		mockMovieRepo.ClearAll()
+	}
+
+	tests := []struct {
+		name           string
+		mockServiceReq searchMoviesReq
+
+		wantServiceOrRepoCallWithAndResponse func()
+		wantServiceOrRepoCallTimes           map[string]map[string]int
+		wantMainServiceError                 error
+		wantMainServiceResponse              interface{}
+	}{
+		{
+			name:           "Test should return error when query is blank",
+			mockServiceReq: searchMoviesReq{query: "  ", pageSize: 2},
+			wantServiceOrRepoCallTimes: map[string]map[string]int{
+				"movieRepository": {
+					"Search": 0,
+				},
+			},
+			wantMainServiceError:    NewInvalidArgumentError("invalid search", FieldViolation{Field: "query", Description: "must not be empty"}),
+			wantMainServiceResponse: nil,
+		},
+		{
+			name:           "Test should return error when cursor is invalid",
+			mockServiceReq: searchMoviesReq{query: "dune", pageSize: 2, cursor: "not-a-cursor"},
+			wantServiceOrRepoCallTimes: map[string]map[string]int{
+				"movieRepository": {
+					"Search": 0,
+				},
+			},
+			wantMainServiceError:    ErrInvalidCursor,
+			wantMainServiceResponse: nil,
+		},
+		{
+			name:           "Test should return error when movie repository Search returns error",
+			mockServiceReq: searchMoviesReq{query: "dune", pageSize: 2},
+			wantServiceOrRepoCallWithAndResponse: func() {
+				mockMovieRepo.On("Search", mock.Anything, "dune", 3, int64(0)).Return(nil, assert.AnError)
+			},
+			wantServiceOrRepoCallTimes: map[string]map[string]int{
+				"movieRepository": {
+					"Search": 1,
+				},
+			},
+			wantMainServiceError:    assert.AnError,
+			wantMainServiceResponse: nil,
+		},
+		{
+			name:           "Test should trim query and return next cursor when movie repository Search returns more than page size",
+			mockServiceReq: searchMoviesReq{query: " dune ", pageSize: 1, cursor: encodeMovieCursor(domain.Movie{ID: 4}, domain.MovieSort{})},
+			wantServiceOrRepoCallWithAndResponse: func() {
+				mockMovieRepo.On("Search", mock.Anything, "dune", 2, int64(4)).Return([]domain.Movie{{ID: 5}, {ID: 9}}, nil)
+			},
+			wantServiceOrRepoCallTimes: map[string]map[string]int{
+				"movieRepository": {
+					"Search": 1,
+				},
+			},
+			wantMainServiceError: nil,
+			wantMainServiceResponse: &domain.MoviePage{
+				Edges: []domain.MovieEdge{
+					{Movie: domain.Movie{ID: 5}, Cursor: encodeMovieCursor(domain.Movie{ID: 5}, domain.MovieSort{})},
+				},
+				NextCursor: encodeMovieCursor(domain.Movie{ID: 5}, domain.MovieSort{}),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			defer clearAllMock()
+
+			if test.wantServiceOrRepoCallWithAndResponse != nil {
+				test.wantServiceOrRepoCallWithAndResponse()
+			}
+
+			movieUsecase := NewMovieUsecase(mockMovieRepo, mockPosterRepo, mockMovieEventBus)
+			response, err := movieUsecase.SearchMovies(authorizedContext(), test.mockServiceReq.query, test.mockServiceReq.pageSize, test.mockServiceReq.cursor)
+
+			if test.wantMainServiceError != nil {
+				assert.Equal(t, test.wantMainServiceError.Error(), err.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+
+			if test.wantMainServiceResponse != nil {
+				assert.Equal(t, test.wantMainServiceResponse, response)
+			} else {
+				assert.Nil(t, response)
+			}
+
+			for serviceName, serviceCallTimes := range test.wantServiceOrRepoCallTimes {
+				for methodName, times := range serviceCallTimes {
+					switch serviceName {
+					case "movieRepository":
+						mockMovieRepo.AssertNumberOfCalls(t, methodName, times)
+					default:
+						t.Errorf("service %s or method %s not found", serviceName, methodName)
+					}
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,127 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sorrawichYooboon/go-protocol-api-style/internal/domain"
+	mockRepo "github.com/sorrawichYooboon/go-protocol-api-style/internal/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_movieUsecase_streamMovies(t *testing.T) {
+	mockMovieRepo := mockRepo.NewMovieRepository(t)
+	mockPosterRepo := mockRepo.NewPosterRepository(t)
+	mockMovieEventBus := mockRepo.NewMovieEventBus(t)
+	mockMovieIterator := mockRepo.NewMovieIterator(t)
+
+	clearAllMock := func() {
+		mockMovieRepo.ClearAll()
+		mockMovieIterator.ClearAll()
+	}
+
+	canceledCtx, cancel := context.WithCancel(authorizedContext())
+	cancel()
+
+	tests := []struct {
+		name           string
+		mockServiceReq context.Context
+
+		wantServiceOrRepoCallWithAndResponse func()
+		wantServiceOrRepoCallTimes           map[string]map[string]int
+		wantMainServiceError                 error
+		wantMainServiceResponse              []*domain.Movie
+	}{
+		{
+			name:           "Test should return error when movie repository Iterate returns error",
+			mockServiceReq: authorizedContext(),
+			wantServiceOrRepoCallWithAndResponse: func() {
+				mockMovieRepo.On("Iterate", mock.Anything).Return(nil, assert.AnError)
+			},
+			wantServiceOrRepoCallTimes: map[string]map[string]int{
+				"movieRepository": {
+					"Iterate": 1,
+				},
+			},
+			wantMainServiceError: assert.AnError,
+		},
+		{
+			name:           "Test should send every movie and close iterator when movie iterator has rows",
+			mockServiceReq: authorizedContext(),
+			wantServiceOrRepoCallWithAndResponse: func() {
+				mockMovieRepo.On("Iterate", mock.Anything).Return(mockMovieIterator, nil)
+				mockMovieIterator.On("Next").Return(true).Twice()
+				mockMovieIterator.On("Next").Return(false).Once()
+				mockMovieIterator.On("Movie").Return(&domain.Movie{ID: 1}, nil).Once()
+				mockMovieIterator.On("Movie").Return(&domain.Movie{ID: 2}, nil).Once()
+				mockMovieIterator.On("Err").Return(nil)
+				mockMovieIterator.On("Close").Return(nil)
+			},
+			wantServiceOrRepoCallTimes: map[string]map[string]int{
+				"movieRepository": {
+					"Iterate": 1,
+				},
+				"movieIterator": {
+					"Movie": 2,
+					"Close": 1,
+				},
+			},
+			wantMainServiceResponse: []*domain.Movie{{ID: 1}, {ID: 2}},
+		},
+		{
+			name:           "Test should stop and close iterator when context is canceled",
+			mockServiceReq: canceledCtx,
+			wantServiceOrRepoCallWithAndResponse: func() {
+				mockMovieRepo.On("Iterate", mock.Anything).Return(mockMovieIterator, nil)
+				mockMovieIterator.On("Next").Return(true)
+				mockMovieIterator.On("Close").Return(nil)
+			},
+			wantServiceOrRepoCallTimes: map[string]map[string]int{
+				"movieIterator": {
+					"Movie": 0,
+					"Close": 1,
+				},
+			},
+			wantMainServiceError: context.Canceled,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			defer clearAllMock()
+
+			if test.wantServiceOrRepoCallWithAndResponse != nil {
+				test.wantServiceOrRepoCallWithAndResponse()
+			}
+
+			var response []*domain.Movie
+			movieUsecase := NewMovieUsecase(mockMovieRepo, mockPosterRepo, mockMovieEventBus)
+			err := movieUsecase.StreamMovies(test.mockServiceReq, func(m *domain.Movie) error {
+				response = append(response, m)
+				return nil
+			})
+
+			if test.wantMainServiceError != nil {
+				assert.Equal(t, test.wantMainServiceError.Error(), err.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.Equal(t, test.wantMainServiceResponse, response)
+
+			for serviceName, serviceCallTimes := range test.wantServiceOrRepoCallTimes {
+				for methodName, times := range serviceCallTimes {
+					switch serviceName {
+					case "movieRepository":
+						mockMovieRepo.AssertNumberOfCalls(t, methodName, times)
+					case "movieIterator":
+						mockMovieIterator.AssertNumberOfCalls(t, methodName, times)
+					default:
+						t.Errorf("service %s or method %s not found", serviceName, methodName)
+					}
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,91 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sorrawichYooboon/go-protocol-api-style/internal/domain"
+	mockRepo "github.com/sorrawichYooboon/go-protocol-api-style/internal/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_movieUsecase_subscribeMovieChanges(t *testing.T) {
+	mockMovieRepo := mockRepo.NewMovieRepository(t)
+	mockPosterRepo := mockRepo.NewPosterRepository(t)
+	mockMovieEventBus := mockRepo.NewMovieEventBus(t)
+
+	clearAllMock := func() {
+		mockMovieRepo.ClearAll()
+		mockMovieEventBus.ClearAll()
+	}
+
+	events := make(chan domain.MovieEvent)
+
+	tests := []struct {
+		name           string
+		mockServiceReq context.Context
+
+		wantServiceOrRepoCallWithAndResponse func()
+		wantServiceOrRepoCallTimes           map[string]map[string]int
+		wantMainServiceError                 error
+		wantMainServiceResponse              <-chan domain.MovieEvent
+	}{
+		{
+			name:           "Test should return permission denied error when principal lacks read scope",
+			mockServiceReq: authorizedContext(ScopeMoviesWrite),
+			wantServiceOrRepoCallTimes: map[string]map[string]int{
+				"movieEventBus": {
+					"Subscribe": 0,
+				},
+			},
+			wantMainServiceError:    NewPermissionDeniedError("missing scope " + ScopeMoviesRead),
+			wantMainServiceResponse: nil,
+		},
+		{
+			name:           "Test should return movie event bus subscription when principal has read scope",
+			mockServiceReq: authorizedContext(),
+			wantServiceOrRepoCallWithAndResponse: func() {
+				mockMovieEventBus.On("Subscribe", mock.Anything).Return((<-chan domain.MovieEvent)(events))
+			},
+			wantServiceOrRepoCallTimes: map[string]map[string]int{
+				"movieEventBus": {
+					"Subscribe": 1,
+				},
+			},
+			wantMainServiceError:    nil,
+			wantMainServiceResponse: events,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			defer clearAllMock()
+
+			if test.wantServiceOrRepoCallWithAndResponse != nil {
+				test.wantServiceOrRepoCallWithAndResponse()
+			}
+
+			movieUsecase := NewMovieUsecase(mockMovieRepo, mockPosterRepo, mockMovieEventBus)
+			response, err := movieUsecase.SubscribeMovieChanges(test.mockServiceReq)
+
+			if test.wantMainServiceError != nil {
+				assert.Equal(t, test.wantMainServiceError.Error(), err.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, test.wantMainServiceResponse, response)
+
+			for serviceName, serviceCallTimes := range test.wantServiceOrRepoCallTimes {
+				for methodName, times := range serviceCallTimes {
+					switch serviceName {
+					case "movieEventBus":
+						mockMovieEventBus.AssertNumberOfCalls(t, methodName, times)
+					default:
+						t.Errorf("service %s or method %s not found", serviceName, methodName)
+					}
+				}
+			}
+		})
+	}
+}
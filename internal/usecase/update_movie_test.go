@@ -0,0 +1,149 @@
+package usecase
+
+import (
+	"testing"
+	"time"
+
+	"github.com/sorrawichYooboon/go-protocol-api-style/internal/domain"
+	mockRepo "github.com/sorrawichYooboon/go-protocol-api-style/internal/mock"
+	"github.com/sorrawichYooboon/go-protocol-api-style/internal/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_movieUsecase_updateMovie(t *testing.T) {
+	mockMovieRepo := mockRepo.NewMovieRepository(t)
+	mockPosterRepo := mockRepo.NewPosterRepository(t)
+	mockMovieEventBus := mockRepo.NewMovieEventBus(t)
+
+	clearAllMock := func() {
+		mockMovieRepo.ClearAll()
+		mockMovieEventBus.ClearAll()
+	}
+
+	validMovie := &domain.Movie{ID: 4, Title: "Dune: Part One", ReleaseDate: domain.Date{Year: 2021, Month: time.October, Day: 22}}
+
+	tests := []struct {
+		name           string
+		mockServiceReq *domain.Movie
+
+		wantServiceOrRepoCallWithAndResponse func()
+		wantServiceOrRepoCallTimes           map[string]map[string]int
+		wantMainServiceError                 error
+		wantMainServiceErrorCode             ErrorCode
+		wantMainServiceResponse              interface{}
+	}{
+		{
+			name:           "Test should return invalid argument error when movie is invalid",
+			mockServiceReq: &domain.Movie{ID: 4, Title: " "},
+			wantServiceOrRepoCallTimes: map[string]map[string]int{
+				"movieRepository": {
+					"Update": 0,
+				},
+				"movieEventBus": {
+					"Publish": 0,
+				},
+			},
+			wantMainServiceError: NewInvalidArgumentError("invalid movie",
+				FieldViolation{Field: "title", Description: "must not be empty"},
+				FieldViolation{Field: "releaseDate", Description: "must not be empty"},
+			),
+			wantMainServiceErrorCode: ErrCodeInvalidArgument,
+			wantMainServiceResponse:  nil,
+		},
+		{
+			name:           "Test should return not found error when movie repository Update returns nil",
+			mockServiceReq: validMovie,
+			wantServiceOrRepoCallWithAndResponse: func() {
+				mockMovieRepo.On("Update", mock.Anything, validMovie).Return(nil, nil)
+			},
+			wantServiceOrRepoCallTimes: map[string]map[string]int{
+				"movieRepository": {
+					"Update": 1,
+				},
+				"movieEventBus": {
+					"Publish": 0,
+				},
+			},
+			wantMainServiceError:     NewNotFoundError("movie 4 not found"),
+			wantMainServiceErrorCode: ErrCodeNotFound,
+			wantMainServiceResponse:  nil,
+		},
+		{
+			name:           "Test should return conflict error when movie repository Update returns conflict",
+			mockServiceReq: validMovie,
+			wantServiceOrRepoCallWithAndResponse: func() {
+				mockMovieRepo.On("Update", mock.Anything, validMovie).Return(nil, repository.ErrConflict)
+			},
+			wantServiceOrRepoCallTimes: map[string]map[string]int{
+				"movieRepository": {
+					"Update": 1,
+				},
+				"movieEventBus": {
+					"Publish": 0,
+				},
+			},
+			wantMainServiceError:     &Error{Code: ErrCodeConflict, Message: "movie conflicts with an existing record", Err: repository.ErrConflict},
+			wantMainServiceErrorCode: ErrCodeConflict,
+			wantMainServiceResponse:  nil,
+		},
+		{
+			name:           "Test should return movie and publish updated event when movie repository Update returns movie",
+			mockServiceReq: validMovie,
+			wantServiceOrRepoCallWithAndResponse: func() {
+				mockMovieRepo.On("Update", mock.Anything, validMovie).Return(validMovie, nil)
+				mockMovieEventBus.On("Publish", domain.MovieEvent{Type: domain.MovieUpdated, Movie: *validMovie}).Return()
+			},
+			wantServiceOrRepoCallTimes: map[string]map[string]int{
+				"movieRepository": {
+					"Update": 1,
+				},
+				"movieEventBus": {
+					"Publish": 1,
+				},
+			},
+			wantMainServiceError:    nil,
+			wantMainServiceResponse: validMovie,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			defer clearAllMock()
+
+			if test.wantServiceOrRepoCallWithAndResponse != nil {
+				test.wantServiceOrRepoCallWithAndResponse()
+			}
+
+			movieUsecase := NewMovieUsecase(mockMovieRepo, mockPosterRepo, mockMovieEventBus)
+			response, err := movieUsecase.UpdateMovie(authorizedContext(), test.mockServiceReq)
+
+			if test.wantMainServiceError != nil {
+				assert.Equal(t, test.wantMainServiceError.Error(), err.Error())
+				assert.Equal(t, test.wantMainServiceErrorCode, AsError(err).Code)
+				assert.Equal(t, AsError(test.wantMainServiceError).Violations, AsError(err).Violations)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			if test.wantMainServiceResponse != nil {
+				assert.Equal(t, test.wantMainServiceResponse, response)
+			} else {
+				assert.Nil(t, response)
+			}
+
+			for serviceName, serviceCallTimes := range test.wantServiceOrRepoCallTimes {
+				for methodName, times := range serviceCallTimes {
+					switch serviceName {
+					case "movieRepository":
+						mockMovieRepo.AssertNumberOfCalls(t, methodName, times)
+					case "movieEventBus":
+						mockMovieEventBus.AssertNumberOfCalls(t, methodName, times)
+					default:
+						t.Errorf("service %s or method %s not found", serviceName, methodName)
+					}
+				}
+			}
+		})
+	}
+}
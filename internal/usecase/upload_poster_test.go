@@ -0,0 +1,138 @@
+package usecase
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/sorrawichYooboon/go-protocol-api-style/internal/domain"
+	mockRepo "github.com/sorrawichYooboon/go-protocol-api-style/internal/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var testPNG = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+
+type uploadPosterReq struct {
+	movieID int64
+	data    []byte
+}
+
+func Test_posterUsecase_uploadPoster(t *testing.T) {
+	mockMovieRepo := mockRepo.NewMovieRepository(t)
+	mockPosterRepo := mockRepo.NewPosterRepository(t)
+
+	clearAllMock := func() {
+		mockMovieRepo.ClearAll()
+		mockPosterRepo.ClearAll()
+	}
+
+	tests := []struct {
+		name           string
+		mockServiceReq uploadPosterReq
+
+		wantServiceOrRepoCallWithAndResponse func()
+		wantServiceOrRepoCallTimes           map[string]map[string]int
+		wantMainServiceError                 error
+		wantMainServiceResponse              interface{}
+	}{
+		{
+			name:           "Test should return error when image is not a supported format",
+			mockServiceReq: uploadPosterReq{movieID: 1, data: []byte("plain text")},
+			wantServiceOrRepoCallTimes: map[string]map[string]int{
+				"movieRepository":  {"GetByID": 0},
+				"posterRepository": {"Put": 0},
+			},
+			wantMainServiceError:    NewInvalidArgumentError("invalid poster", FieldViolation{Field: "image", Description: "must be a PNG, JPEG, GIF or WebP image"}),
+			wantMainServiceResponse: nil,
+		},
+		{
+			name:           "Test should return error when image is too large",
+			mockServiceReq: uploadPosterReq{movieID: 1, data: append(bytes.Clone(testPNG), make([]byte, MaxPosterSize)...)},
+			wantServiceOrRepoCallTimes: map[string]map[string]int{
+				"movieRepository":  {"GetByID": 0},
+				"posterRepository": {"Put": 0},
+			},
+			wantMainServiceError:    NewInvalidArgumentError("invalid poster", FieldViolation{Field: "image", Description: "must be at most 5 MiB"}),
+			wantMainServiceResponse: nil,
+		},
+		{
+			name:           "Test should return not found error when movie repository GetByID returns nil",
+			mockServiceReq: uploadPosterReq{movieID: 2, data: testPNG},
+			wantServiceOrRepoCallWithAndResponse: func() {
+				mockMovieRepo.On("GetByID", mock.Anything, int64(2)).Return(nil, nil)
+			},
+			wantServiceOrRepoCallTimes: map[string]map[string]int{
+				"movieRepository":  {"GetByID": 1},
+				"posterRepository": {"Put": 0},
+			},
+			wantMainServiceError:    NewNotFoundError("movie 2 not found"),
+			wantMainServiceResponse: nil,
+		},
+		{
+			name:           "Test should return error when poster repository Put returns error",
+			mockServiceReq: uploadPosterReq{movieID: 3, data: testPNG},
+			wantServiceOrRepoCallWithAndResponse: func() {
+				mockMovieRepo.On("GetByID", mock.Anything, int64(3)).Return(&domain.Movie{ID: 3}, nil)
+				mockPosterRepo.On("Put", mock.Anything, mock.Anything).Return(assert.AnError)
+			},
+			wantServiceOrRepoCallTimes: map[string]map[string]int{
+				"movieRepository":  {"GetByID": 1},
+				"posterRepository": {"Put": 1},
+			},
+			wantMainServiceError:    assert.AnError,
+			wantMainServiceResponse: nil,
+		},
+		{
+			name:           "Test should store poster with sniffed content type",
+			mockServiceReq: uploadPosterReq{movieID: 4, data: testPNG},
+			wantServiceOrRepoCallWithAndResponse: func() {
+				mockMovieRepo.On("GetByID", mock.Anything, int64(4)).Return(&domain.Movie{ID: 4}, nil)
+				mockPosterRepo.On("Put", mock.Anything, &domain.Poster{MovieID: 4, ContentType: "image/png", Data: testPNG}).Return(nil)
+			},
+			wantServiceOrRepoCallTimes: map[string]map[string]int{
+				"movieRepository":  {"GetByID": 1},
+				"posterRepository": {"Put": 1},
+			},
+			wantMainServiceError:    nil,
+			wantMainServiceResponse: &domain.Poster{MovieID: 4, ContentType: "image/png", Data: testPNG},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			defer clearAllMock()
+
+			if test.wantServiceOrRepoCallWithAndResponse != nil {
+				test.wantServiceOrRepoCallWithAndResponse()
+			}
+
+			posterUsecase := NewPosterUsecase(mockMovieRepo, mockPosterRepo)
+			response, err := posterUsecase.UploadPoster(authorizedContext(), test.mockServiceReq.movieID, test.mockServiceReq.data)
+
+			if test.wantMainServiceError != nil {
+				assert.Equal(t, test.wantMainServiceError.Error(), err.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+
+			if test.wantMainServiceResponse != nil {
+				assert.Equal(t, test.wantMainServiceResponse, response)
+			} else {
+				assert.Nil(t, response)
+			}
+
+			for serviceName, serviceCallTimes := range test.wantServiceOrRepoCallTimes {
+				for methodName, times := range serviceCallTimes {
+					switch serviceName {
+					case "movieRepository":
+						mockMovieRepo.AssertNumberOfCalls(t, methodName, times)
+					case "posterRepository":
+						mockPosterRepo.AssertNumberOfCalls(t, methodName, times)
+					default:
+						t.Errorf("service %s or method %s not found", serviceName, methodName)
+					}
+				}
+			}
+		})
+	}
+}
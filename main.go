@@ -1,25 +1,35 @@
 package main
 
 import (
+	"context"
 	"log"
-	"net"
 	"os"
+	"os/signal"
+	"syscall"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/sorrawichYooboon/go-protocol-api-style/config"
 	"github.com/sorrawichYooboon/go-protocol-api-style/graph"
+	"github.com/sorrawichYooboon/go-protocol-api-style/internal/infrastructure/auth"
+	"github.com/sorrawichYooboon/go-protocol-api-style/internal/infrastructure/blob"
 	"github.com/sorrawichYooboon/go-protocol-api-style/internal/infrastructure/database"
+	"github.com/sorrawichYooboon/go-protocol-api-style/internal/infrastructure/eventbus"
 	"github.com/sorrawichYooboon/go-protocol-api-style/internal/infrastructure/graphql"
 	grpcinfra "github.com/sorrawichYooboon/go-protocol-api-style/internal/infrastructure/grpc"
 	"github.com/sorrawichYooboon/go-protocol-api-style/internal/infrastructure/grpc/moviepb"
 	"github.com/sorrawichYooboon/go-protocol-api-style/internal/infrastructure/http"
 	httphandler "github.com/sorrawichYooboon/go-protocol-api-style/internal/infrastructure/http/handler"
+	"github.com/sorrawichYooboon/go-protocol-api-style/internal/infrastructure/lifecycle"
+	"github.com/sorrawichYooboon/go-protocol-api-style/internal/infrastructure/ratelimit"
 	"github.com/sorrawichYooboon/go-protocol-api-style/internal/infrastructure/soap"
 	soaphandler "github.com/sorrawichYooboon/go-protocol-api-style/internal/infrastructure/soap/handler"
+	"github.com/sorrawichYooboon/go-protocol-api-style/internal/infrastructure/soap/wsse"
 	"github.com/sorrawichYooboon/go-protocol-api-style/internal/usecase"
 	"github.com/sorrawichYooboon/go-protocol-api-style/logger"
 	"github.com/sorrawichYooboon/go-protocol-api-style/migrations"
 	"google.golang.org/grpc"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
 )
 
@@ -32,40 +42,117 @@ func main() {
 	migrations.RunMigrations(cfg)
 	logger.InitLogger()
 
+	migrationVersion, err := migrations.LatestVersion()
+	if err != nil {
+		log.Fatalf("Failed to read migrations: %v", err)
+	}
+
 	movieRepo := database.NewMovieRepository(db)
-	movieUsecase := usecase.NewMovieUsecase(movieRepo)
+	movieEvents := eventbus.NewMovieBus()
+	posterRepo, err := blob.NewFilesystemPosterRepository(cfg.PosterDir)
+	if err != nil {
+		log.Fatalf("Failed to open poster store: %v", err)
+	}
+	movieUsecase := usecase.NewMovieUsecase(movieRepo, posterRepo, movieEvents)
+	posterUsecase := usecase.NewPosterUsecase(movieRepo, posterRepo)
+	apiKeyRepo := database.NewAPIKeyRepository(db)
+	authUsecase := usecase.NewAuthUsecase(apiKeyRepo)
+	jwtVerifier, err := auth.NewJWTVerifier(auth.JWTConfig{
+		HS256Secret: cfg.JWTSecret,
+		JWKSFile:    cfg.JWTJWKSFile,
+		Issuer:      cfg.JWTIssuer,
+		Audience:    cfg.JWTAudience,
+	})
+	if err != nil {
+		log.Fatalf("Failed to configure JWT validation: %v", err)
+	}
+	authenticator := auth.NewAuthenticator(authUsecase, jwtVerifier)
+	healthRepo := database.NewHealthRepository(db)
+	healthUsecase := usecase.NewHealthUsecase(healthRepo, migrationVersion)
+
+	limiter := ratelimit.NewLimiter(cfg.RateLimitRPS, cfg.RateLimitBurst)
 
 	router := gin.Default()
+	// The rate limiter keys anonymous clients by IP, so only proxies we run
+	// may name the client in X-Forwarded-For.
+	if err := router.SetTrustedProxies(cfg.TrustedProxies); err != nil {
+		log.Fatalf("Invalid TRUSTED_PROXIES: %v", err)
+	}
+
+	healthHandler := httphandler.NewHealthHandler(healthUsecase)
+	http.SetupHealthRoutes(router, healthHandler)
 
 	movieHandler := httphandler.NewMovieHandler(movieUsecase)
-	http.SetupRoutes(router, movieHandler)
-
-	gqlResolver := &graph.Resolver{MovieUsecase: movieUsecase}
-	router.POST("/graphql", graphql.GraphqlHandler(gqlResolver))
-	router.GET("/playground", graphql.PlaygroundHandler())
-
-	movieSOAPHandler := soaphandler.NewMovieSOAPHandler(movieUsecase)
-	soap.SetupSOAPRoutes(router, movieSOAPHandler)
-
-	go func() {
-		grpcPort := ":50051"
-		lis, err := net.Listen("tcp", grpcPort)
-		if err != nil {
-			log.Fatalf("Failed to listen gRPC: %v", err)
-		}
-		grpcServer := grpc.NewServer()
-		reflection.Register(grpcServer) // for development purposes, in production you might want to disable this because it exposes all services
-		moviepb.RegisterMovieServiceServer(grpcServer, grpcinfra.NewMovieServer(movieUsecase))
-		log.Printf("gRPC server running at %s", grpcPort)
-		if err := grpcServer.Serve(lis); err != nil {
-			log.Fatalf("Failed to serve gRPC: %v", err)
-		}
-	}()
-
-	port := os.Getenv("PORT")
-	if port == "" {
-		port = "8081"
+	httpRateLimit := ratelimit.Middleware(limiter, httphandler.AbortWithProblem)
+	httpAuth := auth.Middleware(authenticator, httphandler.AbortWithProblem)
+	// The limiter runs once per client IP before authentication, which also
+	// throttles guessing keys, and once per principal after it.
+	http.SetupRoutes(router, movieHandler, httpRateLimit, httpAuth, httpRateLimit)
+
+	// Closed when the HTTP server shuts down, to end subscription sockets.
+	httpShutdown := make(chan struct{})
+	gqlResolver := &graph.Resolver{MovieUsecase: movieUsecase, HealthUsecase: healthUsecase}
+	gqlHandler := graphql.GraphqlHandler(gqlResolver, graphql.Config{
+		ComplexityLimit: cfg.GraphQLComplexityLimit,
+		DepthLimit:      cfg.GraphQLDepthLimit,
+		Introspection:   cfg.GraphQLIntrospection,
+
+		APQCacheSize:         cfg.GraphQLAPQCacheSize,
+		PersistedQueriesOnly: cfg.GraphQLPersistedQueriesOnly,
+		Shutdown:             httpShutdown,
+	}, auth.WebsocketInit(authenticator))
+	router.POST("/graphql", httpRateLimit, httpAuth, httpRateLimit, gqlHandler)
+	// WebSocket upgrades for subscriptions authenticate on connection_init.
+	router.GET("/graphql", httpRateLimit, gqlHandler)
+	// Probes that speak GraphQL query health here without credentials; the
+	// endpoint serves no other root field.
+	gqlHealthHandler := graphql.GraphqlHandler(gqlResolver, graphql.Config{
+		ComplexityLimit: cfg.GraphQLComplexityLimit,
+		DepthLimit:      cfg.GraphQLDepthLimit,
+		RootFields:      []string{"health"},
+		Shutdown:        httpShutdown,
+	}, nil)
+	router.POST("/graphql/health", httpRateLimit, gqlHealthHandler)
+	if cfg.GraphQLIntrospection {
+		router.GET("/playground", graphql.PlaygroundHandler())
+	}
+
+	wsseValidator := wsse.NewValidator(wsse.Config{Users: cfg.WSSEUsers, Scopes: cfg.WSSEScopes})
+	movieSOAPHandler := soaphandler.NewMovieSOAPHandler(movieUsecase, posterUsecase, authUsecase, wsseValidator, limiter, cfg.SOAPPublicURL)
+	soap.SetupSOAPRoutes(router, movieSOAPHandler, ratelimit.Middleware(limiter, movieSOAPHandler.AbortWithFault))
+
+	grpcServer := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(
+			grpcinfra.ErrorUnaryInterceptor(),
+			ratelimit.UnaryInterceptor(limiter),
+			auth.UnaryInterceptor(authenticator),
+			ratelimit.UnaryInterceptor(limiter),
+		),
+		grpc.ChainStreamInterceptor(
+			grpcinfra.ErrorStreamInterceptor(),
+			ratelimit.StreamInterceptor(limiter),
+			auth.StreamInterceptor(authenticator),
+			ratelimit.StreamInterceptor(limiter),
+		),
+	)
+	reflection.Register(grpcServer) // for development purposes, in production you might want to disable this because it exposes all services
+	moviepb.RegisterMovieServiceServer(grpcServer, grpcinfra.NewMovieServer(movieUsecase))
+	healthReporter := grpcinfra.NewHealthReporter(healthUsecase, 10*time.Second)
+	healthpb.RegisterHealthServer(grpcServer, healthReporter.Server)
+
+	manager := lifecycle.NewManager(cfg.ShutdownTimeout)
+	manager.Add(lifecycle.NewHTTPServer(":"+cfg.HTTPPort, router, func() { close(httpShutdown) }))
+	manager.Add(lifecycle.NewGRPCServer(":"+cfg.GRPCPort, grpcServer))
+	manager.Add(healthReporter)
+	manager.OnClose("database", func() error { return database.Close(db) })
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	log.Printf("Server running at :%s (REST, GraphQL, Playground, SOAP) and gRPC at :%s", cfg.HTTPPort, cfg.GRPCPort)
+	if err := manager.Run(ctx); err != nil {
+		log.Printf("Server stopped with error: %v", err)
+		stop()
+		os.Exit(1)
 	}
-	log.Printf("Server running at :%s (REST, GraphQL, Playground)", port)
-	router.Run(":" + port)
 }
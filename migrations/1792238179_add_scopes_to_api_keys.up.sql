@@ -0,0 +1,3 @@
+-- Keys issued before scopes existed keep full access.
+ALTER TABLE api_keys ADD COLUMN IF NOT EXISTS scopes TEXT NOT NULL DEFAULT 'movies:read movies:write';
+ALTER TABLE api_keys ALTER COLUMN scopes SET DEFAULT 'movies:read';
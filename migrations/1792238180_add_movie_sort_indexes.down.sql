@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS movies_release_date_id_idx;
+DROP INDEX IF EXISTS movies_title_id_idx;
\ No newline at end of file
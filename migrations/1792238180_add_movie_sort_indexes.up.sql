@@ -0,0 +1,3 @@
+-- Keyset pagination orders by (sort column, id).
+CREATE INDEX IF NOT EXISTS movies_title_id_idx ON movies (title, id);
+CREATE INDEX IF NOT EXISTS movies_release_date_id_idx ON movies (release_date, id);
@@ -1,20 +1,32 @@
 package migrations
 
 import (
+	"embed"
+	"errors"
 	"fmt"
+	"io/fs"
 	"log"
 
 	"github.com/golang-migrate/migrate/v4"
 	_ "github.com/golang-migrate/migrate/v4/database/postgres"
-	_ "github.com/golang-migrate/migrate/v4/source/file"
+	"github.com/golang-migrate/migrate/v4/source/iofs"
 	"github.com/sorrawichYooboon/go-protocol-api-style/config"
 )
 
+// The migrations are compiled into the binary, so neither applying them nor
+// readiness depends on the working directory the service is started from.
+//
+//go:embed *.sql
+var files embed.FS
+
 func RunMigrations(cfg *config.Config) {
-	dbPath := "file://./migrations"
 	databaseURL := fmt.Sprintf("postgres://%s:%s@%s:%d/%s?sslmode=disable", cfg.DatabaseUser, cfg.DatabasePassword, cfg.DatabaseHost, cfg.DatabasePort, cfg.DatabaseDBName)
 
-	m, err := migrate.New(dbPath, databaseURL)
+	src, err := iofs.New(files, ".")
+	if err != nil {
+		log.Fatalf("Failed to read embedded migrations: %v", err)
+	}
+	m, err := migrate.NewWithSourceInstance("iofs", src, databaseURL)
 	if err != nil {
 		log.Fatalf("Failed to initialize migrations: %v", err)
 	}
@@ -26,3 +38,28 @@ func RunMigrations(cfg *config.Config) {
 
 	log.Println("Database migrations applied successfully.")
 }
+
+// LatestVersion returns the highest migration version shipped with the
+// binary, i.e. the version a fully migrated database should report.
+func LatestVersion() (uint, error) {
+	src, err := iofs.New(files, ".")
+	if err != nil {
+		return 0, err
+	}
+	defer src.Close()
+
+	version, err := src.First()
+	if err != nil {
+		return 0, err
+	}
+	for {
+		next, err := src.Next(version)
+		if errors.Is(err, fs.ErrNotExist) {
+			return version, nil
+		}
+		if err != nil {
+			return 0, err
+		}
+		version = next
+	}
+}
@@ -0,0 +1,32 @@
+package migrations
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLatestVersion_IndependentOfWorkingDirectory(t *testing.T) {
+	ups, err := filepath.Glob("*.up.sql")
+	require.NoError(t, err)
+	var want uint64
+	for _, up := range ups {
+		version, err := strconv.ParseUint(strings.SplitN(up, "_", 2)[0], 10, 64)
+		require.NoError(t, err)
+		want = max(want, version)
+	}
+
+	wd, err := os.Getwd()
+	require.NoError(t, err)
+	require.NoError(t, os.Chdir(t.TempDir()))
+	defer os.Chdir(wd)
+
+	version, err := LatestVersion()
+	require.NoError(t, err)
+	assert.Equal(t, uint(want), version)
+}